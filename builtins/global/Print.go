@@ -13,7 +13,10 @@ func Print(env *runtime.EnvironmentInterface, params map[string]types.Value) (ty
 	strings := make([]any, 0)
 
 	for _, paramVal := range params {
-		strValue := paramVal.String()
+		strValue, err := types.Stringify(paramVal)
+		if err != nil {
+			return nil, err
+		}
 		strings = append(strings, strValue)
 	}
 
@@ -0,0 +1,33 @@
+package include
+
+import (
+	"bytes"
+	"encoding/csv"
+	"zen/runtime/types"
+)
+
+// LoadCSV converts a CSV document to an Array with a Map for each record, whose keys are the column names in the first record.
+// Values are strings
+func LoadCSV(content []byte) (types.Value, error) {
+	records, err := csv.NewReader(bytes.NewReader(content)).ReadAll()
+	if err != nil {
+		return nil, types.NewTypeError("invalid CSV: %s", err)
+	}
+
+	rows := make([]types.Value, 0)
+	if len(records) == 0 {
+		return untypedArray(rows), nil
+	}
+
+	header := records[0]
+	for _, record := range records[1:] {
+		row := untypedMap()
+		for idx, column := range header {
+			if err := row.Set(types.NewString(column), types.NewString(record[idx])); err != nil {
+				return nil, err
+			}
+		}
+		rows = append(rows, row)
+	}
+	return untypedArray(rows), nil
+}
@@ -0,0 +1,83 @@
+package include
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"zen/runtime/types"
+)
+
+// LoadJSON converts a JSON document to a zen value.
+// Objects become a Map keeping the order of their keys, arrays an Array, and numbers an int or float like the literals of zen code
+func LoadJSON(content []byte) (types.Value, error) {
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+
+	value, err := decodeJSON(decoder)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, types.NewTypeError("invalid JSON: unexpected data after the top-level value")
+	}
+	return value, nil
+}
+
+// decodeJSON converts the next JSON value read by a decoder
+func decodeJSON(decoder *json.Decoder) (types.Value, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, types.NewTypeError("invalid JSON: %s", err)
+	}
+
+	switch t := token.(type) {
+	case json.Delim:
+		if t == '[' {
+			elements := make([]types.Value, 0)
+			for decoder.More() {
+				element, err := decodeJSON(decoder)
+				if err != nil {
+					return nil, err
+				}
+				elements = append(elements, element)
+			}
+			_, err := decoder.Token() // The closing ']'
+			return untypedArray(elements), err
+		}
+
+		m := untypedMap()
+		for decoder.More() {
+			key, err := decoder.Token()
+			if err != nil {
+				return nil, types.NewTypeError("invalid JSON: %s", err)
+			}
+			value, err := decodeJSON(decoder)
+			if err != nil {
+				return nil, err
+			}
+			if err := m.Set(types.NewString(key.(string)), value); err != nil {
+				return nil, err
+			}
+		}
+		_, err := decoder.Token() // The closing '}'
+		return m, err
+	case json.Number:
+		if !strings.ContainsAny(t.String(), ".eE") {
+			if integer, err := t.Int64(); err == nil {
+				return types.NewInt64(integer), nil
+			}
+		}
+		float, err := t.Float64()
+		if err != nil {
+			return nil, types.NewTypeError("invalid JSON number %s", t)
+		}
+		return types.NewFloat64(float), nil
+	case string:
+		return types.NewString(t), nil
+	case bool:
+		return types.NewBool(t), nil
+	default:
+		return types.NewNull(), nil
+	}
+}
@@ -0,0 +1,54 @@
+package include
+
+import (
+	"strings"
+	"sync"
+	"zen/runtime/types"
+)
+
+// Loader converts the content of a file passed to include to a zen value, e.g. a JSON object to a Map
+type Loader func(content []byte) (types.Value, error)
+
+var (
+	loadersMutex sync.RWMutex
+	// loaders by file extension, in lower case with the leading dot
+	loaders = map[string]Loader{
+		".json": LoadJSON,
+		".csv":  LoadCSV,
+	}
+)
+
+// RegisterLoader sets the loader for files with the given extension, e.g. ".toml", replacing any loader registered for it.
+// Zen files are always executed and can't have a loader
+func RegisterLoader(extension string, loader Loader) {
+	loadersMutex.Lock()
+	defer loadersMutex.Unlock()
+	loaders[normalizeExtension(extension)] = loader
+}
+
+// LoaderFor returns the loader for files with the given extension, or false if there is none
+func LoaderFor(extension string) (Loader, bool) {
+	loadersMutex.RLock()
+	defer loadersMutex.RUnlock()
+	loader, ok := loaders[normalizeExtension(extension)]
+	return loader, ok
+}
+
+// normalizeExtension returns an extension in lower case with a leading dot
+func normalizeExtension(extension string) string {
+	extension = strings.ToLower(extension)
+	if !strings.HasPrefix(extension, ".") {
+		extension = "." + extension
+	}
+	return extension
+}
+
+// untypedMap returns an empty Map<any, any>, like a map literal
+func untypedMap() *types.Map {
+	return types.NewMap(types.NewTypeHint("any", false), types.NewTypeHint("any", false))
+}
+
+// untypedArray returns an Array<any> of the given elements, like an array literal
+func untypedArray(elements []types.Value) *types.Array {
+	return types.NewArray(elements, types.NewTypeHint("any", false), types.Unbounded)
+}
@@ -0,0 +1,60 @@
+package interpreter
+
+import (
+	"zen/lang/common"
+	"zen/runtime/errors"
+)
+
+// MaxCallDepth is the maximum number of nested function calls before execution is aborted
+const MaxCallDepth = 1000
+
+// CallFrame records an active function call
+type CallFrame struct {
+	// Name of the function being called
+	Function string
+	// Location of the call expression
+	Location *common.SourceLocation
+}
+
+// pushFrame records the start of a function call
+// Returns an error if the maximum call depth would be exceeded
+func (i *Interpreter) pushFrame(function string, location *common.SourceLocation) error {
+	if len(i.callStack) >= MaxCallDepth {
+		return &RuntimeError{
+			Message:  "Maximum call depth exceeded in call to '" + function + "'",
+			Location: location,
+		}
+	}
+	i.callStack = append(i.callStack, &CallFrame{
+		Function: function,
+		Location: location,
+	})
+	return nil
+}
+
+// popFrame removes the innermost call frame
+func (i *Interpreter) popFrame() {
+	i.callStack = i.callStack[:len(i.callStack)-1]
+}
+
+// stackTrace returns the active function calls, innermost first, followed by the top level of the program.
+// Each frame holds the location its function is executing at, which is the given location for the innermost frame
+// and the call to the next frame for the others
+func (i *Interpreter) stackTrace(location *common.SourceLocation) []*errors.StackFrame {
+	stack := make([]*errors.StackFrame, 0, len(i.callStack)+1)
+	current := location
+	for idx := len(i.callStack) - 1; idx >= 0; idx-- {
+		stack = append(stack, &errors.StackFrame{Function: i.callStack[idx].Function, Location: current})
+		current = i.callStack[idx].Location
+	}
+	return append(stack, &errors.StackFrame{Function: "<main>", Location: current})
+}
+
+// attachStack records the stack trace on a runtime error raised within the innermost active function call,
+// or at the top level of the program. Errors leaving a function call already carry the stack trace of where they were raised
+func (i *Interpreter) attachStack(err error) error {
+	if runtimeErr, ok := err.(*RuntimeError); ok && runtimeErr.Stack == nil {
+		runtimeErr.Stack = i.stackTrace(runtimeErr.Location)
+	}
+	return err
+}
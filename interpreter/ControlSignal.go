@@ -0,0 +1,35 @@
+package interpreter
+
+import (
+	"zen/lang/common"
+	"zen/runtime/types"
+)
+
+// returnSignal unwinds execution from a return statement up to the function call it returns from.
+// It travels up through ExecuteStatement as an error, but is always consumed by the call
+type returnSignal struct {
+	Value    types.Value
+	Location *common.SourceLocation
+}
+
+func (s *returnSignal) Error() string {
+	return "return outside of a function"
+}
+
+// breakSignal unwinds execution from a break statement up to the innermost loop, which stops
+type breakSignal struct {
+	Location *common.SourceLocation
+}
+
+func (s *breakSignal) Error() string {
+	return "break outside of a loop"
+}
+
+// continueSignal unwinds execution from a continue statement up to the innermost loop, which moves on to its next iteration
+type continueSignal struct {
+	Location *common.SourceLocation
+}
+
+func (s *continueSignal) Error() string {
+	return "continue outside of a loop"
+}
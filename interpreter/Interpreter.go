@@ -6,7 +6,9 @@ import (
 	"zen/lang/parsing/ast"
 	"zen/lang/parsing/expression"
 	"zen/lang/parsing/statement"
+	"zen/runtime/async"
 	"zen/runtime/environment"
+	"zen/runtime/errors"
 	"zen/runtime/types"
 )
 
@@ -18,30 +20,101 @@ type Interpreter struct {
 	inFunction bool
 	// Track whether we're in a loop
 	inLoop bool
+	// Track whether we're executing a file passed to include, whose top level may return a value
+	inInclude bool
+	// Track whether we're in an async function, which may await
+	inAsync bool
+	// The active function calls, innermost last
+	callStack []*CallFrame
+	// The renamed trait members of the function being executed, see types.UserFunction.Aliases
+	memberAliases map[string]string
+	// The class whose private and protected members the function being executed can access, see types.UserFunction.AccessClass
+	currentClass *types.Class
+	// The subjects of the when statements whose predicates are being evaluated, innermost last
+	whenSubjects []types.Value
+	// The when statements that have already been reported as non-exhaustive
+	warnedWhens map[*statement.WhenStatement]bool
+	// Warnings reported during execution, in order
+	warnings []*RuntimeWarning
+	// The calls deferred by the active function calls and the program, innermost last, see executeDeferStatement
+	deferred [][]*deferredCall
+	// The built-in exception classes by name, see errors.DeclareExceptionClasses
+	exceptionClasses map[string]*types.Class
+	// The modules loaded by import statements by the absolute path of their file, see loadModule
+	modules map[string]*Module
+	// The shareable constants of the modules loaded by the isolate that spawned this one, by the absolute path of their file.
+	// A spawned isolate loads these modules by executing their declarations only, see loadModule
+	spawnedModules map[string]map[string]types.Value
+	// The modules being loaded, outermost first, to detect import cycles
+	loading []*Module
+	// The paths of the zen files being included, outermost first, to detect include cycles, see includeFile
+	including []string
+	// The program being executed, as the module of its top-level scope
+	main *Module
+	// The names declared by the package.zen files looked up so far by their path, empty for missing files, see findPackageRoot
+	packageNames map[string]string
+	// The event loop running the program and the async calls it makes
+	loop *async.EventLoop
+	// The task being executed, see taskState
+	task *async.Task
 }
 
 // NewInterpreter creates a new interpreter instance
-// Built-in functions are registered automatically
+// Built-in functions and exception classes are registered automatically
 func NewInterpreter() *Interpreter {
 	interp := &Interpreter{
-		env:        environment.NewEnvironment(),
-		inFunction: false,
-		inLoop:     false,
+		env:          environment.NewEnvironment(),
+		inFunction:   false,
+		inLoop:       false,
+		warnedWhens:  make(map[*statement.WhenStatement]bool),
+		modules:      make(map[string]*Module),
+		packageNames: make(map[string]string),
+		loop:         async.NewEventLoop(),
 	}
 
-	interp.env.RegisterBuiltInFunctions()
+	interp.registerBuiltins()
+	// The program has its own top-level scope like every module, whose parent holds the built-ins
+	interp.env.BeginScope()
 
 	return interp
 }
 
-// Execute runs a complete Zen program
+// Execute runs a complete Zen program as the main task of the event loop.
+// Once the main task completes, the loop runs until the tasks started by async calls have completed as well, see async.EventLoop.Run.
+// Returns the error the program fails with, or else the error of a rejected promise that was never awaited
 func (i *Interpreter) Execute(program *ast.ProgramNode) error {
+	var err error
+	var final *taskState
+	main := async.NewTask("<main>", func(task *async.Task) {
+		i.task = task
+		err = i.executeProgram(program)
+		final = i.saveTaskState()
+	})
+
+	unhandled := i.loop.Run(main)
+	// Variables of the program are read from its top-level scope afterwards, see GetValue
+	i.restoreTaskState(final)
+	// The program fails with ErrAborted if the loop stopped early because of an unhandled rejection, which is the actual error
+	if err != nil && err != async.ErrAborted {
+		return err
+	}
+	return i.attachStack(unhandled)
+}
+
+// executeProgram executes the statements of a program, once it's checked for access to members it can't access, see checkVisibility.
+// Top-level deferred expressions are evaluated once the program exits, whether it completes or fails
+func (i *Interpreter) executeProgram(program *ast.ProgramNode) error {
+	if err := checkVisibility(program); err != nil {
+		return err
+	}
+	i.main = &Module{Name: "<main>", Scope: i.env.CurrentScope(), Program: program}
+	i.beginDeferred()
 	for _, stmt := range program.Statements {
 		if err := i.ExecuteStatement(stmt); err != nil {
-			return err
+			return i.attachStack(i.runDeferred(err))
 		}
 	}
-	return nil
+	return i.attachStack(i.runDeferred(nil))
 }
 
 // ExecuteStatement executes a single statement
@@ -55,6 +128,40 @@ func (i *Interpreter) ExecuteStatement(stmt ast.Statement) error {
 		return i.executeIfStatement(s)
 	case *statement.WhileStatement:
 		return i.executeWhileStatement(s)
+	case *statement.ForStatement:
+		return i.executeForStatement(s)
+	case *statement.ForInStatement:
+		return i.executeForInStatement(s)
+	case *statement.WhenStatement:
+		return i.executeWhenStatement(s)
+	case *statement.BreakStatement:
+		return i.executeBreakStatement(s)
+	case *statement.ContinueStatement:
+		return i.executeContinueStatement(s)
+	case *statement.FuncDeclaration:
+		return i.executeFuncDeclaration(s)
+	case *statement.ClassDeclaration:
+		return i.executeClassDeclaration(s)
+	case *statement.InterfaceDeclaration:
+		return i.executeInterfaceDeclaration(s)
+	case *statement.TraitDeclaration:
+		return i.executeTraitDeclaration(s)
+	case *statement.ReturnStatmenet:
+		return i.executeReturnStatement(s)
+	case *statement.DestructuringDeclaration:
+		return i.executeDestructuringDeclaration(s)
+	case *statement.ThrowStatement:
+		return i.executeThrowStatement(s)
+	case *statement.TryStatement:
+		return i.executeTryStatement(s)
+	case *statement.DeferStatement:
+		return i.executeDeferStatement(s)
+	case *statement.PackageDeclaration:
+		return nil
+	case *statement.ImportStatement:
+		return i.executeImportStatement(s)
+	case *statement.SelectStatement:
+		return i.executeSelectStatement(s)
 	default:
 		return &RuntimeError{
 			Message:  "Unknown statement type",
@@ -63,8 +170,15 @@ func (i *Interpreter) ExecuteStatement(stmt ast.Statement) error {
 	}
 }
 
+// SetClock replaces the clock timers are scheduled with, e.g. with an async.VirtualClock so tests don't wait for real time to pass.
+// Must be called before the program is executed
+func (i *Interpreter) SetClock(clock async.Clock) {
+	i.loop.SetClock(clock)
+}
+
 // GetValue retrieves a variable's value from the current environment
-func (i *Interpreter) GetValue(name string) (types.Value, error) {
+// Primitives are returned as Go values (int32, string, etc.), see types.ToGoValue
+func (i *Interpreter) GetValue(name string) (interface{}, error) {
 	val, err := i.env.Get(name)
 	if err != nil {
 		return nil, err
@@ -76,6 +190,11 @@ func (i *Interpreter) GetValue(name string) (types.Value, error) {
 type RuntimeError struct {
 	Message  string
 	Location *common.SourceLocation
+	// Exception is the name of the built-in exception class the error is caught as, RuntimeException if empty.
+	// See errors.DeclareExceptionClasses
+	Exception string
+	// Stack holds the function calls that were active when the error was raised, innermost first, see attachStack
+	Stack []*errors.StackFrame
 }
 
 func (e *RuntimeError) Error() string {
@@ -85,6 +204,32 @@ func (e *RuntimeError) Error() string {
 	return fmt.Sprintf("Runtime error: %s", e.Message)
 }
 
+// RuntimeWarning represents a problem that doesn't stop the program, such as a non-exhaustive when statement
+type RuntimeWarning struct {
+	Message  string
+	Location *common.SourceLocation
+}
+
+func (w *RuntimeWarning) String() string {
+	if w.Location != nil {
+		return fmt.Sprintf("Warning at %s: %s", w.Location.String(), w.Message)
+	}
+	return fmt.Sprintf("Warning: %s", w.Message)
+}
+
+// Warnings returns the warnings reported during execution
+func (i *Interpreter) Warnings() []*RuntimeWarning {
+	return i.warnings
+}
+
+// warn reports a warning
+func (i *Interpreter) warn(message string, location *common.SourceLocation) {
+	i.warnings = append(i.warnings, &RuntimeWarning{
+		Message:  message,
+		Location: location,
+	})
+}
+
 // EvaluateExpression evaluates an expression and returns a Zen value
 func (i *Interpreter) EvaluateExpression(expr ast.Expression) (types.Value, error) {
 	switch e := expr.(type) {
@@ -98,6 +243,36 @@ func (i *Interpreter) EvaluateExpression(expr ast.Expression) (types.Value, erro
 		return i.evaluateBinary(e)
 	case *expression.CallExpression:
 		return i.evaluateCall(e)
+	case *expression.LambdaExpression:
+		return i.evaluateLambda(e)
+	case *expression.ArrayLiteralExpression:
+		return i.evaluateArrayLiteral(e)
+	case *expression.ArrayAccessExpression:
+		return i.evaluateArrayAccess(e)
+	case *expression.MemberAccessExpression:
+		return i.evaluateMemberAccess(e)
+	case *expression.MapLiteralExpression:
+		return i.evaluateMapLiteral(e)
+	case *expression.MapAccessExpression:
+		return i.evaluateMapAccess(e)
+	case *expression.RangeExpression:
+		return i.evaluateRange(e)
+	case *expression.WhereExpression:
+		return i.evaluateWhere(e)
+	case *expression.WhenSubjectExpression:
+		return i.evaluateWhenSubject(e)
+	case *expression.NewExpression:
+		return i.evaluateNew(e)
+	case *expression.IsTypeExpression:
+		return i.evaluateIs(e)
+	case *expression.HasExpression:
+		return i.evaluateHas(e)
+	case *expression.TupleExpression:
+		return i.evaluateTuple(e)
+	case *expression.AwaitExpression:
+		return i.evaluateAwait(e)
+	case *expression.SpawnExpression:
+		return i.evaluateSpawn(e)
 	default:
 		return nil, &RuntimeError{
 			Message:  "Unknown expression type: " + fmt.Sprintf("%T", e),
@@ -124,12 +299,20 @@ func (i *Interpreter) evaluateIdentifier(expr *expression.IdentifierExpression)
 	value, err := i.GetValue(expr.Name)
 	if err != nil {
 		return nil, &RuntimeError{
-			Message:  fmt.Sprintf("Undefined variable '%s'", expr.Name),
-			Location: expr.GetLocation(),
+			Message:   fmt.Sprintf("Undefined variable '%s'", expr.Name),
+			Location:  expr.GetLocation(),
+			Exception: errors.UndefinedVariableExceptionClass,
 		}
 	}
 
-	return value, nil
+	val, err := types.FromGoValue(value)
+	if err != nil {
+		return nil, &RuntimeError{
+			Message:  err.Error(),
+			Location: expr.GetLocation(),
+		}
+	}
+	return val, nil
 }
 
 // evaluateUnary handles unary operations (-x, not x)
@@ -139,12 +322,9 @@ func (i *Interpreter) evaluateUnary(expr *expression.UnaryExpression) (types.Val
 		return nil, err
 	}
 
-	result, err := types.UnaryOp(operand, expr.Operator)
+	result, err := types.UnaryOp(operand, expr.Operator, i.methodCaller(expr.GetLocation()))
 	if err != nil {
-		return nil, &RuntimeError{
-			Message:  err.Error(),
-			Location: expr.GetLocation(),
-		}
+		return nil, operationError(err, expr.GetLocation())
 	}
 	return result, nil
 }
@@ -153,18 +333,49 @@ func (i *Interpreter) evaluateUnary(expr *expression.UnaryExpression) (types.Val
 func (i *Interpreter) evaluateBinary(expr *expression.BinaryExpression) (types.Value, error) {
 	// Handle assignment separately since right side should only be evaluated if needed
 	if expr.Operator == "=" {
-		if id, ok := expr.Left.(*expression.IdentifierExpression); ok {
+		switch target := expr.Left.(type) {
+		case *expression.IdentifierExpression:
 			right, err := i.EvaluateExpression(expr.Right)
 			if err != nil {
 				return nil, err
 			}
-			if err := i.env.Assign(id.Name, types.ToGoValue(right)); err != nil {
+			if right, err = i.checkAssignment(target.Name, right, expr.GetLocation()); err != nil {
+				return nil, err
+			}
+			if err := i.env.Assign(target.Name, types.ToGoValue(right)); err != nil {
 				return nil, &RuntimeError{
 					Message:  err.Error(),
 					Location: expr.GetLocation(),
 				}
 			}
 			return right, nil
+		case *expression.ArrayAccessExpression:
+			right, err := i.EvaluateExpression(expr.Right)
+			if err != nil {
+				return nil, err
+			}
+			if err := i.assignArrayElement(target, right); err != nil {
+				return nil, err
+			}
+			return right, nil
+		case *expression.MapAccessExpression:
+			right, err := i.EvaluateExpression(expr.Right)
+			if err != nil {
+				return nil, err
+			}
+			if err := i.assignMapEntry(target, right); err != nil {
+				return nil, err
+			}
+			return right, nil
+		case *expression.MemberAccessExpression:
+			right, err := i.EvaluateExpression(expr.Right)
+			if err != nil {
+				return nil, err
+			}
+			if err := i.assignMember(target, right); err != nil {
+				return nil, err
+			}
+			return right, nil
 		}
 		return nil, &RuntimeError{
 			Message:  "Invalid assignment target",
@@ -211,7 +422,7 @@ func (i *Interpreter) evaluateBinary(expr *expression.BinaryExpression) (types.V
 			}
 		}
 
-		result, err := types.BinaryOp(left, right, expr.Operator)
+		result, err := types.BinaryOp(left, right, expr.Operator, nil)
 		if err != nil {
 			return nil, &RuntimeError{
 				Message:  err.Error(),
@@ -232,33 +443,54 @@ func (i *Interpreter) evaluateBinary(expr *expression.BinaryExpression) (types.V
 		return nil, err
 	}
 
-	result, err := types.BinaryOp(left, right, expr.Operator)
+	result, err := types.BinaryOp(left, right, expr.Operator, i.methodCaller(expr.GetLocation()))
 	if err != nil {
-		return nil, &RuntimeError{
-			Message:  err.Error(),
-			Location: expr.GetLocation(),
-		}
+		return nil, operationError(err, expr.GetLocation())
 	}
 	return result, nil
 }
 
-// evaluateCall handles function calls
-func (i *Interpreter) evaluateCall(expr *expression.CallExpression) (types.Value, error) {
-	// evaluate Callee, if it resolves to a Callable, we call it. Otherwise we return an error
-	callee, err := i.EvaluateExpression(expr.Callee)
-	if err != nil {
-		return nil, err
+// checkAssignment checks a value assigned to a variable against the type the variable was declared with, if any,
+// returning the value as the variable holds it, e.g. a map literal bound to Map<string, int>.
+// A declared type holds for the lifetime of the variable, not just its initializer: otherwise assigning a Map<string, string>
+// to a Map<string, int> variable would get around the key and value types the map enforces
+func (i *Interpreter) checkAssignment(name string, value types.Value, location *common.SourceLocation) (types.Value, error) {
+	hint, ok := i.env.GetTypeHint(name).(*types.TypeHint)
+	if !ok {
+		return value, nil
 	}
-
-	// Check that callee is callable
-	if !types.IsCallable(callee) {
+	checked, err := hint.Check(value)
+	if err != nil {
 		return nil, &RuntimeError{
-			Message:  fmt.Sprintf("Cannot call value of type %s", callee.Type()),
-			Location: expr.GetLocation(),
+			Message:   fmt.Sprintf("Cannot assign to '%s': %s", name, err.Error()),
+			Location:  location,
+			Exception: errors.TypeExceptionClass,
 		}
 	}
+	return checked, nil
+}
 
-	return i.env.Call(expr)
+// operationError reports a type error of an operation at the given location, which is caught as a TypeException,
+// or a DivisionByZeroException for dividing by zero, and an index error, which is caught as an IndexException.
+// Errors raised by operator methods are returned as they are, they already carry the location they occurred at
+func operationError(err error, location *common.SourceLocation) error {
+	var exception string
+	switch err.(type) {
+	case *types.TypeError:
+		exception = errors.TypeExceptionClass
+		if err == types.ErrDivisionByZero {
+			exception = errors.DivisionByZeroExceptionClass
+		}
+	case *types.IndexError:
+		exception = errors.IndexExceptionClass
+	default:
+		return err
+	}
+	return &RuntimeError{
+		Message:   err.Error(),
+		Location:  location,
+		Exception: exception,
+	}
 }
 
 // executeVarDeclaration handles variable and constant declarations
@@ -266,17 +498,40 @@ func (i *Interpreter) executeVarDeclaration(stmt *statement.VarDeclarationNode)
 	var value interface{}
 	var err error
 
+	// Resolve the declared type, if any
+	var hint *types.TypeHint
+	if stmt.Type != nil {
+		hint, err = i.resolveTypeHint(stmt.Type, stmt.IsNullable)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Evaluate initializer if present
 	if stmt.Initializer != nil {
 		val, err := i.EvaluateExpression(stmt.Initializer)
 		if err != nil {
 			return err
 		}
+
+		if hint != nil {
+			val, err = hint.Check(val)
+			if err != nil {
+				return &RuntimeError{
+					Message:   fmt.Sprintf("Cannot initialize '%s': %s", stmt.Name, err.Error()),
+					Location:  stmt.GetLocation(),
+					Exception: errors.TypeExceptionClass,
+				}
+			}
+		}
 		value = types.ToGoValue(val)
+	} else if hint != nil && !stmt.IsNullable && isCollectionType(hint) {
+		// Collections without an initializer start out empty
+		value = hint.ZeroValue()
 	}
 
 	// If no initializer and not nullable, that's an error
-	if stmt.Initializer == nil && !stmt.IsNullable {
+	if value == nil && stmt.Initializer == nil && !stmt.IsNullable {
 		return &RuntimeError{
 			Message:  fmt.Sprintf("Variable '%s' must either be initialized or declared as nullable.", stmt.Name),
 			Location: stmt.GetLocation(),
@@ -298,6 +553,10 @@ func (i *Interpreter) executeVarDeclaration(stmt *statement.VarDeclarationNode)
 		err = i.env.Define(stmt.Name, value)
 	}
 
+	if err == nil && hint != nil {
+		err = i.env.SetTypeHint(stmt.Name, hint)
+	}
+
 	if err != nil {
 		return &RuntimeError{
 			Message:  err.Error(),
@@ -305,9 +564,29 @@ func (i *Interpreter) executeVarDeclaration(stmt *statement.VarDeclarationNode)
 		}
 	}
 
+	return i.declareVisibility(stmt.Name, stmt.Visibility, stmt.GetLocation())
+}
+
+// declareVisibility records the visibility of a top-level declaration defined in the current scope.
+// Private declarations are accessible within their module, but can't be imported by other modules
+func (i *Interpreter) declareVisibility(name string, visibility ast.Visibility, location *common.SourceLocation) error {
+	if visibility != ast.Private {
+		return nil
+	}
+	if err := i.env.SetPrivate(name); err != nil {
+		return &RuntimeError{
+			Message:  err.Error(),
+			Location: location,
+		}
+	}
 	return nil
 }
 
+// isCollectionType returns true for Array and Map types, which have an empty zero value
+func isCollectionType(hint *types.TypeHint) bool {
+	return hint.Name == "Array" || hint.Name == "Map"
+}
+
 // executeExpressionStatement handles expressions used as statements
 func (i *Interpreter) executeExpressionStatement(stmt *statement.ExpressionStatement) error {
 	_, err := i.EvaluateExpression(stmt.Expression)
@@ -414,14 +693,13 @@ func (i *Interpreter) executeWhileStatement(stmt *statement.WhileStatement) erro
 		}
 
 		// Execute body in new scope
-		i.env.BeginScope()
-		for _, statement := range stmt.Body {
-			if err := i.ExecuteStatement(statement); err != nil {
-				i.env.EndScope()
-				return err
-			}
+		stop, err := i.executeLoopBody(stmt.Body, nil)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
 		}
-		i.env.EndScope()
 	}
 
 	return nil
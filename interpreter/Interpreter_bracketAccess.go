@@ -0,0 +1,43 @@
+package interpreter
+
+import (
+	"fmt"
+	"zen/lang/common"
+	"zen/runtime/types"
+)
+
+// implementsBrackets returns true for objects whose class declares _bracketGet or _bracketSet.
+// Their elements are read and assigned through these methods with both array access, e.g. grid[0],
+// and curly access, e.g. config{"volume"}, which takes precedence over the Array or Map a class may extend
+func implementsBrackets(value types.Value) bool {
+	object, ok := value.(*types.Object)
+	if !ok {
+		return false
+	}
+	return object.Method(types.BracketGetMethod) != nil || object.Method(types.BracketSetMethod) != nil
+}
+
+// bracketGet reads an element of an object implementing the bracket protocol by calling its _bracketGet method with the key
+func (i *Interpreter) bracketGet(object *types.Object, key types.Value, location *common.SourceLocation) (types.Value, error) {
+	method := object.Method(types.BracketGetMethod)
+	if method == nil {
+		return nil, &RuntimeError{
+			Message:  fmt.Sprintf("Cannot read elements of %s, it has no %s method", object.Class.Name, types.BracketGetMethod),
+			Location: location,
+		}
+	}
+	return i.callMethod(method, []types.Value{key}, nil, location)
+}
+
+// bracketSet assigns an element of an object implementing the bracket protocol by calling its _bracketSet method with the key and value
+func (i *Interpreter) bracketSet(object *types.Object, key types.Value, value types.Value, location *common.SourceLocation) error {
+	method := object.Method(types.BracketSetMethod)
+	if method == nil {
+		return &RuntimeError{
+			Message:  fmt.Sprintf("Cannot assign elements of %s, it has no %s method", object.Class.Name, types.BracketSetMethod),
+			Location: location,
+		}
+	}
+	_, err := i.callMethod(method, []types.Value{key, value}, nil, location)
+	return err
+}
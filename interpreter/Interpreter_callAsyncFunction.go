@@ -0,0 +1,42 @@
+package interpreter
+
+import (
+	"zen/lang/common"
+	"zen/runtime/async"
+	"zen/runtime/types"
+)
+
+// callAsyncFunction calls an async zen function as a new task and returns a promise of its result.
+// The function runs right away until it first awaits, and the caller continues once it does.
+// The promise is fulfilled with the returned value, or rejected with the error the function fails with
+func (i *Interpreter) callAsyncFunction(fn *types.UserFunction, this *types.Object, args []types.Value, typeArgs []types.Value, location *common.SourceLocation) *async.Promise {
+	return i.startAsync(fn.Name, i.newTaskState(), func() (types.Value, error) {
+		return i.runUserFunction(fn, this, args, typeArgs, location)
+	})
+}
+
+// callAsyncBuiltin calls an async builtin as a new task and returns a promise of its result, see callAsyncFunction.
+// Builtins returning a promise settle the returned promise like it
+func (i *Interpreter) callAsyncBuiltin(fn *types.BuiltinFunction, args []types.Value, location *common.SourceLocation) *async.Promise {
+	return i.startAsync(fn.Name, i.newTaskState(), func() (types.Value, error) {
+		return i.runBuiltinFunction(fn, args, location)
+	})
+}
+
+// startAsync starts a task in the given state settling a new promise with the outcome of call, and returns the promise
+func (i *Interpreter) startAsync(name string, state *taskState, call func() (types.Value, error)) *async.Promise {
+	promise := i.loop.NewPromise()
+	task := async.NewTask(name, func(task *async.Task) {
+		i.restoreTaskState(state)
+		i.task = task
+		result, err := call()
+		if err != nil {
+			promise.Reject(err)
+			return
+		}
+		promise.Resolve(result)
+	})
+
+	i.startTask(task)
+	return promise
+}
@@ -0,0 +1,86 @@
+package interpreter
+
+import (
+	"fmt"
+	"zen/lang/parsing/expression"
+	"zen/runtime/types"
+)
+
+// evaluateArrayLiteral creates a new array from an array literal
+// Array literals are untyped (Array<any>) until they are bound to a declared type
+func (i *Interpreter) evaluateArrayLiteral(expr *expression.ArrayLiteralExpression) (types.Value, error) {
+	elements := make([]types.Value, len(expr.Elements))
+	for idx, elementExpr := range expr.Elements {
+		element, err := i.EvaluateExpression(elementExpr)
+		if err != nil {
+			return nil, err
+		}
+		elements[idx] = element
+	}
+
+	return types.NewArray(elements, types.NewTypeHint("any", false), types.Unbounded), nil
+}
+
+// evaluateArrayAccess reads an element of an array, e.g. names[0], or calls _bracketGet of an object
+func (i *Interpreter) evaluateArrayAccess(expr *expression.ArrayAccessExpression) (types.Value, error) {
+	arr, index, err := i.evaluateArrayTarget(expr, false)
+	if err != nil {
+		return nil, err
+	}
+	if object, ok := arr.(*types.Object); ok {
+		return i.bracketGet(object, index, expr.GetLocation())
+	}
+
+	element, err := arr.(*types.Array).Get(index)
+	if err != nil {
+		return nil, operationError(err, expr.GetLocation())
+	}
+	return element, nil
+}
+
+// assignArrayElement handles assignments to an array element, e.g. names[1] = "Janette", or calls _bracketSet of an object
+func (i *Interpreter) assignArrayElement(target *expression.ArrayAccessExpression, value types.Value) error {
+	arr, index, err := i.evaluateArrayTarget(target, true)
+	if err != nil {
+		return err
+	}
+	if object, ok := arr.(*types.Object); ok {
+		return i.bracketSet(object, index, value, target.GetLocation())
+	}
+
+	if err := arr.(*types.Array).Set(index, value); err != nil {
+		return operationError(err, target.GetLocation())
+	}
+	return nil
+}
+
+// evaluateArrayTarget evaluates the array and index of an array access expression, which is read or, if assigning, assigned.
+// The array is either an Array or an object implementing the bracket protocol, see implementsBrackets
+func (i *Interpreter) evaluateArrayTarget(expr *expression.ArrayAccessExpression, assigning bool) (types.Value, types.Value, error) {
+	target, err := i.EvaluateExpression(expr.Array)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Views are accessed like the collection of their matching elements
+	if target, err = i.collectView(target, assigning, expr.GetLocation()); err != nil {
+		return nil, nil, err
+	}
+
+	if !implementsBrackets(target) {
+		// Objects of classes extending Array are indexed like the array they wrap
+		arr, ok := types.Unwrap(target).(*types.Array)
+		if !ok {
+			return nil, nil, &RuntimeError{
+				Message:  fmt.Sprintf("Cannot index into %s", target.Type()),
+				Location: expr.GetLocation(),
+			}
+		}
+		target = arr
+	}
+
+	index, err := i.EvaluateExpression(expr.Index)
+	if err != nil {
+		return nil, nil, err
+	}
+	return target, index, nil
+}
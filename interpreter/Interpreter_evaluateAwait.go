@@ -0,0 +1,53 @@
+package interpreter
+
+import (
+	"zen/lang/parsing/expression"
+	"zen/runtime/async"
+	"zen/runtime/types"
+)
+
+// evaluateAwait suspends the current task until the awaited promise settles, letting other tasks run meanwhile.
+// Evaluates to the value the promise is fulfilled with, or raises the error it's rejected with.
+// Awaiting a value that isn't a promise evaluates to that value, once the tasks scheduled before have run.
+// Only async functions and the top level of the program may await
+func (i *Interpreter) evaluateAwait(expr *expression.AwaitExpression) (types.Value, error) {
+	if i.inFunction && !i.inAsync {
+		return nil, &RuntimeError{
+			Message:  "'await' is only allowed in async functions and at the top level",
+			Location: expr.GetLocation(),
+		}
+	}
+
+	value, err := i.EvaluateExpression(expr.Expression)
+	if err != nil {
+		return nil, err
+	}
+	promise, ok := value.(*async.Promise)
+	if !ok {
+		promise = i.loop.Resolved(value)
+	}
+
+	state := i.saveTaskState()
+	result, err := i.task.Await(promise)
+	i.restoreTaskState(state)
+
+	if err == async.ErrStalled {
+		return nil, &RuntimeError{
+			Message:  "Awaited promise never settles",
+			Location: expr.GetLocation(),
+		}
+	}
+	if err == async.ErrDeadlock {
+		return nil, &RuntimeError{
+			Message:  err.Error(),
+			Location: expr.GetLocation(),
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return types.NewNull(), nil
+	}
+	return result, nil
+}
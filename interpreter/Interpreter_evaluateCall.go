@@ -0,0 +1,389 @@
+package interpreter
+
+import (
+	"fmt"
+	"zen/lang/common"
+	"zen/lang/parsing/expression"
+	"zen/lang/parsing/statement"
+	"zen/runtime/errors"
+	"zen/runtime/types"
+)
+
+// evaluateCall handles function calls
+func (i *Interpreter) evaluateCall(expr *expression.CallExpression) (types.Value, error) {
+	callee, args, typeArgs, err := i.resolveCall(expr)
+	if err != nil {
+		return nil, err
+	}
+	return i.callFunction(callee, args, typeArgs, expr.GetLocation())
+}
+
+// resolveCall evaluates the callee, arguments and type arguments of a call without making it
+func (i *Interpreter) resolveCall(expr *expression.CallExpression) (types.Value, []types.Value, []types.Value, error) {
+	// evaluate Callee, if it resolves to a Callable, we call it. Otherwise we return an error
+	callee, err := i.EvaluateExpression(expr.Callee)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// Check that callee is callable
+	if !types.IsCallable(callee) {
+		return nil, nil, nil, &RuntimeError{
+			Message:  fmt.Sprintf("Cannot call value of type %s", callee.Type()),
+			Location: expr.GetLocation(),
+		}
+	}
+
+	// Evaluate arguments from left to right
+	args := make([]types.Value, len(expr.Arguments))
+	for idx, argExpr := range expr.Arguments {
+		arg, err := i.EvaluateExpression(argExpr)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		args[idx] = arg
+	}
+
+	typeArgs, err := i.resolveTypeArguments(expr.TypeArguments)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return callee, args, typeArgs, nil
+}
+
+// callFunction calls a callable value with already evaluated arguments
+// typeArgs are the type arguments of a generic function or class, or nil to infer them.
+// location is the call site, used for error reporting and the call stack
+func (i *Interpreter) callFunction(callee types.Value, args []types.Value, typeArgs []types.Value, location *common.SourceLocation) (types.Value, error) {
+	switch fn := callee.(type) {
+	case *types.UserFunction:
+		return i.callUserFunction(fn, args, typeArgs, location)
+	case *types.BuiltinFunction:
+		if len(typeArgs) > 0 {
+			return nil, &RuntimeError{
+				Message:  fmt.Sprintf("Function '%s' is not generic and takes no type arguments", fn.Name),
+				Location: location,
+			}
+		}
+		return i.callBuiltinFunction(fn, args, location)
+	case *types.BoundMethod:
+		return i.callMethod(fn, args, typeArgs, location)
+	case *types.Class:
+		return i.instantiate(fn, args, typeArgs, location)
+	default:
+		return nil, &RuntimeError{
+			Message:  fmt.Sprintf("Cannot call value of type %s", callee.Type()),
+			Location: location,
+		}
+	}
+}
+
+// callUserFunction executes the body of a zen function in a fresh scope whose parent is the function's closure
+// Arguments are bound to the declared parameters and checked against their types,
+// and the returned value is checked against the declared return type.
+func (i *Interpreter) callUserFunction(fn *types.UserFunction, args []types.Value, typeArgs []types.Value, location *common.SourceLocation) (types.Value, error) {
+	return i.invokeUserFunction(fn, nil, args, typeArgs, location)
+}
+
+// invokeUserFunction calls a zen function, see callUserFunction.
+// If this is not nil, the function is called as a method of that object, which it can refer to as 'this'.
+// Async functions run as a new task and return a promise of their result, see callAsyncFunction
+func (i *Interpreter) invokeUserFunction(fn *types.UserFunction, this *types.Object, args []types.Value, typeArgs []types.Value, location *common.SourceLocation) (types.Value, error) {
+	if fn.Async {
+		return i.callAsyncFunction(fn, this, args, typeArgs, location), nil
+	}
+	return i.runUserFunction(fn, this, args, typeArgs, location)
+}
+
+// runUserFunction executes a zen function in the current task, see invokeUserFunction.
+// Methods of classes extending another class can also refer to the superclass as 'super'.
+// The type parameters of a generic method's class are bound to the type arguments of the object,
+// and those of a generic function to typeArgs, or the ones inferred from the arguments if nil
+func (i *Interpreter) runUserFunction(fn *types.UserFunction, this *types.Object, args []types.Value, typeArgs []types.Value, location *common.SourceLocation) (result types.Value, err error) {
+	if len(args) > len(fn.Parameters) {
+		return nil, &RuntimeError{
+			Message:  fmt.Sprintf("Function '%s' expects at most %d argument(s), got %d", fn.Name, len(fn.Parameters), len(args)),
+			Location: location,
+		}
+	}
+
+	// Functions execute in their own scope, which sees the variables where the function was declared,
+	// but not the caller's local variables
+	previous := i.env.BeginScopeFrom(fn.Closure)
+	defer i.env.RestoreScope(previous)
+
+	wasInFunction, wasInAsync, wasInLoop, wasAliases, wasClass := i.inFunction, i.inAsync, i.inLoop, i.memberAliases, i.currentClass
+	i.inFunction, i.inAsync, i.inLoop, i.memberAliases, i.currentClass = true, fn.Async, false, fn.Aliases, fn.AccessClass()
+	defer func() {
+		i.inFunction, i.inAsync, i.inLoop, i.memberAliases, i.currentClass = wasInFunction, wasInAsync, wasInLoop, wasAliases, wasClass
+	}()
+
+	if this != nil {
+		if err := i.env.DefineConst("this", this); err != nil {
+			return nil, err
+		}
+		if super := superOf(fn, this); super != nil {
+			if err := i.env.DefineConst("super", super); err != nil {
+				return nil, err
+			}
+		}
+		if fn.Owner != nil && len(fn.Owner.TypeParameters) > 0 {
+			if err := i.bindTypeArguments(fn.Owner.TypeParameters, this.TypeArguments(fn.Owner)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := i.bindFunctionTypeArguments(fn, args, typeArgs, location); err != nil {
+		return nil, err
+	}
+
+	if err := i.bindParameters(fn, args, location); err != nil {
+		return nil, err
+	}
+
+	result, err = i.runFunctionBody(fn, location)
+	if err != nil {
+		return nil, err
+	}
+	return i.checkReturnValue(fn, result, location)
+}
+
+// runFunctionBody executes the body of a function call and the expressions it defers, within the call frame of the function.
+// Invalid arguments and return values are reported outside of it, at the call site in the caller's frame
+func (i *Interpreter) runFunctionBody(fn *types.UserFunction, location *common.SourceLocation) (result types.Value, err error) {
+	if err := i.pushFrame(fn.Name, location); err != nil {
+		return nil, err
+	}
+	// Errors raised within the call carry the stack trace of where they were raised
+	defer func() {
+		err = i.attachStack(err)
+		i.popFrame()
+	}()
+
+	i.beginDeferred()
+	result, err = i.executeFunctionBody(fn)
+	return result, i.runDeferred(err)
+}
+
+// executeFunctionBody executes the statements of a function in the current scope, returning the value it returns.
+// The result is nil if the function body completed without a return statement
+func (i *Interpreter) executeFunctionBody(fn *types.UserFunction) (types.Value, error) {
+	var result types.Value
+	for idx, stmt := range fn.Body {
+		// A lambda without a return statement yields the value of its final expression
+		if exprStmt, ok := stmt.(*statement.ExpressionStatement); ok && fn.Lambda && idx == len(fn.Body)-1 {
+			val, err := i.EvaluateExpression(exprStmt.Expression)
+			if err != nil {
+				return nil, err
+			}
+			result = val
+			break
+		}
+
+		if err := i.ExecuteStatement(stmt); err != nil {
+			if ret, ok := err.(*returnSignal); ok {
+				result = ret.Value
+				break
+			}
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// bindParameters defines each parameter of fn in the current scope
+// Missing arguments fall back to the parameter's default value, or null for nullable parameters
+func (i *Interpreter) bindParameters(fn *types.UserFunction, args []types.Value, location *common.SourceLocation) error {
+	for idx, param := range fn.Parameters {
+		var value types.Value
+		if idx < len(args) {
+			value = args[idx]
+		} else if param.DefaultValue != nil {
+			val, err := i.EvaluateExpression(param.DefaultValue)
+			if err != nil {
+				return err
+			}
+			value = val
+		} else if param.IsNullable {
+			value = types.NewNull()
+		} else {
+			return &RuntimeError{
+				Message:  fmt.Sprintf("Missing argument '%s' in call to '%s'", param.Name, fn.Name),
+				Location: location,
+			}
+		}
+
+		hint, err := i.resolveTypeHint(param.Type, param.IsNullable)
+		if err != nil {
+			return err
+		}
+
+		value, err = hint.Check(value)
+		if err != nil {
+			return &RuntimeError{
+				Message:   fmt.Sprintf("Invalid argument '%s' in call to '%s': %s", param.Name, fn.Name, err.Error()),
+				Location:  location,
+				Exception: errors.TypeExceptionClass,
+			}
+		}
+
+		if param.IsNullable {
+			err = i.env.DefineNullable(param.Name, types.ToGoValue(value))
+		} else {
+			err = i.env.Define(param.Name, types.ToGoValue(value))
+		}
+		if err != nil {
+			return &RuntimeError{
+				Message:  err.Error(),
+				Location: param.GetLocation(),
+			}
+		}
+	}
+	return nil
+}
+
+// checkReturnValue verifies the value returned from fn against its declared return type
+// result is nil if the function body completed without a return statement
+func (i *Interpreter) checkReturnValue(fn *types.UserFunction, result types.Value, location *common.SourceLocation) (types.Value, error) {
+	// Lambdas don't declare a return type
+	if fn.ReturnType == nil {
+		if result == nil {
+			return types.NewNull(), nil
+		}
+		return result, nil
+	}
+
+	hint, err := i.resolveTypeHint(fn.ReturnType, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if hint.IsVoid() {
+		if result != nil && result.Type() != types.TypeNull {
+			return nil, &RuntimeError{
+				Message:  fmt.Sprintf("Function '%s' is declared void but returned %s", fn.Name, result.Type()),
+				Location: location,
+			}
+		}
+		return types.NewNull(), nil
+	}
+
+	if result == nil {
+		return nil, &RuntimeError{
+			Message:  fmt.Sprintf("Function '%s' must return a value of type %s", fn.Name, hint),
+			Location: location,
+		}
+	}
+
+	if tuple, ok := result.(*types.Tuple); ok && tuple.Names() == nil && hint.Name != "Tuple" {
+		return nil, &RuntimeError{
+			Message:  fmt.Sprintf("Function '%s' returns a single value of type %s, got %d values", fn.Name, hint, tuple.Length()),
+			Location: location,
+		}
+	}
+
+	result, err = hint.Check(result)
+	if err != nil {
+		return nil, &RuntimeError{
+			Message:   fmt.Sprintf("Invalid return value from '%s': %s", fn.Name, err.Error()),
+			Location:  location,
+			Exception: errors.TypeExceptionClass,
+		}
+	}
+	return result, nil
+}
+
+// callBuiltinFunction calls a builtin, see runBuiltinFunction.
+// Async builtins run as a new task and return a promise of their result, see callAsyncBuiltin
+func (i *Interpreter) callBuiltinFunction(fn *types.BuiltinFunction, args []types.Value, location *common.SourceLocation) (types.Value, error) {
+	if fn.Async {
+		return i.callAsyncBuiltin(fn, args, location), nil
+	}
+	return i.runBuiltinFunction(fn, args, location)
+}
+
+// runBuiltinFunction maps the arguments to the builtin's named parameters and calls the underlying Go function in the current task
+// Arguments are converted to the parameter types where possible, e.g. print(5) prints "5"
+func (i *Interpreter) runBuiltinFunction(fn *types.BuiltinFunction, args []types.Value, location *common.SourceLocation) (result types.Value, err error) {
+	if len(args) > len(fn.Parameters) {
+		return nil, &RuntimeError{
+			Message:  fmt.Sprintf("Function '%s' expects at most %d argument(s), got %d", fn.Name, len(fn.Parameters), len(args)),
+			Location: location,
+		}
+	}
+
+	params := make(map[string]types.Value, len(fn.Parameters))
+	for idx, hint := range fn.Parameters {
+		if idx >= len(args) {
+			if !hint.Nullable {
+				return nil, &RuntimeError{
+					Message:  fmt.Sprintf("Missing argument '%s' in call to '%s'", hint.Name, fn.Name),
+					Location: location,
+				}
+			}
+			params[hint.Name] = types.NewNull()
+			continue
+		}
+		// An explicit null is only valid for nullable parameters, and passed as is
+		if args[idx].Type() == types.TypeNull {
+			if !hint.Nullable && hint.Type != types.TypeAny {
+				return nil, &RuntimeError{
+					Message:  fmt.Sprintf("Invalid argument '%s' in call to '%s': expected %s, got null", hint.Name, fn.Name, hint.Type),
+					Location: location,
+				}
+			}
+			params[hint.Name] = args[idx]
+			continue
+		}
+
+		arg, err := types.Convert(args[idx], hint.Type)
+		if err != nil {
+			return nil, &RuntimeError{
+				Message:  fmt.Sprintf("Invalid argument '%s' in call to '%s': %s", hint.Name, fn.Name, err.Error()),
+				Location: location,
+			}
+		}
+		params[hint.Name] = arg
+	}
+
+	if err := i.pushFrame(fn.Name, location); err != nil {
+		return nil, err
+	}
+	// Errors raised within the call carry the stack trace of where they were raised
+	defer func() {
+		err = i.attachStack(err)
+		i.popFrame()
+	}()
+
+	result, err = fn.Call(nil, params)
+	if err != nil {
+		switch err.(type) {
+		case *RuntimeError, *errors.Exception:
+			return nil, err
+		}
+		return nil, &RuntimeError{
+			Message:  err.Error(),
+			Location: location,
+		}
+	}
+	if result == nil {
+		return types.NewNull(), nil
+	}
+	return result, nil
+}
+
+// superOf returns the value of 'super' in a method called on an object, or nil if the declaring class extends nothing.
+// For classes extending a built-in type, super is the wrapped Array or Map, so super.append(x) calls the native method
+func superOf(method *types.UserFunction, this *types.Object) types.Value {
+	if method.Owner == nil {
+		return nil
+	}
+	if method.Owner.Superclass != nil {
+		return types.NewSuperReference(this, method.Owner.Superclass)
+	}
+	if method.Owner.Native != nil {
+		return this.Native()
+	}
+	return nil
+}
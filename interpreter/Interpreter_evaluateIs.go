@@ -0,0 +1,61 @@
+package interpreter
+
+import (
+	"fmt"
+	"zen/lang/parsing/expression"
+	"zen/runtime/types"
+)
+
+// evaluateIs tests whether a value is of a type, e.g. shape is Drawable
+// Objects are of the type of their class, its superclasses and the interfaces they implement
+func (i *Interpreter) evaluateIs(expr *expression.IsTypeExpression) (types.Value, error) {
+	value, err := i.EvaluateExpression(expr.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	hint, err := i.resolveTypeHint(expr.Type, false)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewBool(hint.Matches(value)), nil
+}
+
+// evaluateHas tests whether an object structurally matches an interface, e.g. shape has Drawable,
+// regardless of whether its class implements the interface. With a member, e.g. shape has Drawable.draw,
+// only that member is checked. Values other than objects never match
+func (i *Interpreter) evaluateHas(expr *expression.HasExpression) (types.Value, error) {
+	value, err := i.EvaluateExpression(expr.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	ifaceValue, err := i.EvaluateExpression(expr.Interface)
+	if err != nil {
+		return nil, err
+	}
+	iface, ok := ifaceValue.(*types.Interface)
+	if !ok {
+		return nil, &RuntimeError{
+			Message:  fmt.Sprintf("Expected an interface after 'has', got %s", ifaceValue.Type()),
+			Location: expr.Interface.GetLocation(),
+		}
+	}
+	if expr.Member != "" && !iface.HasMember(expr.Member) {
+		return nil, &RuntimeError{
+			Message:  fmt.Sprintf("Interface '%s' has no member '%s'", iface.Name, expr.Member),
+			Location: expr.GetLocation(),
+		}
+	}
+
+	object, ok := value.(*types.Object)
+	if !ok {
+		return types.NewBool(false), nil
+	}
+
+	mismatch, err := i.interfaceMismatch(object.Class, iface, expr.Member)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewBool(mismatch == ""), nil
+}
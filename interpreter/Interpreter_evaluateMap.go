@@ -0,0 +1,100 @@
+package interpreter
+
+import (
+	"fmt"
+	"zen/lang/parsing/expression"
+	"zen/runtime/types"
+)
+
+// evaluateMapLiteral creates a new map from a map literal, keeping the order of its entries
+// Map literals are untyped (Map<any, any>) until they are bound to a declared type
+func (i *Interpreter) evaluateMapLiteral(expr *expression.MapLiteralExpression) (types.Value, error) {
+	m := types.NewMap(types.NewTypeHint("any", false), types.NewTypeHint("any", false))
+	for _, entry := range expr.Entries {
+		key, err := i.EvaluateExpression(entry.Key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := i.EvaluateExpression(entry.Value)
+		if err != nil {
+			return nil, err
+		}
+		if err := m.Set(key, value); err != nil {
+			return nil, &RuntimeError{
+				Message:  err.Error(),
+				Location: entry.Key.GetLocation(),
+			}
+		}
+	}
+	return m, nil
+}
+
+// evaluateMapAccess reads an entry of a map using curly access, e.g. config{"volume"}, or calls _bracketGet of an object
+func (i *Interpreter) evaluateMapAccess(expr *expression.MapAccessExpression) (types.Value, error) {
+	m, key, err := i.evaluateMapTarget(expr, false)
+	if err != nil {
+		return nil, err
+	}
+	if object, ok := m.(*types.Object); ok {
+		return i.bracketGet(object, key, expr.GetLocation())
+	}
+
+	value, err := m.(*types.Map).Get(key)
+	if err != nil {
+		return nil, &RuntimeError{
+			Message:  err.Error(),
+			Location: expr.GetLocation(),
+		}
+	}
+	return value, nil
+}
+
+// assignMapEntry handles assignments using curly access, e.g. config{"dark_mode"} = false, or calls _bracketSet of an object
+func (i *Interpreter) assignMapEntry(target *expression.MapAccessExpression, value types.Value) error {
+	m, key, err := i.evaluateMapTarget(target, true)
+	if err != nil {
+		return err
+	}
+	if object, ok := m.(*types.Object); ok {
+		return i.bracketSet(object, key, value, target.GetLocation())
+	}
+
+	if err := m.(*types.Map).Set(key, value); err != nil {
+		return &RuntimeError{
+			Message:  err.Error(),
+			Location: target.GetLocation(),
+		}
+	}
+	return nil
+}
+
+// evaluateMapTarget evaluates the map and key of a map access expression, which is read or, if assigning, assigned.
+// The map is either a Map or an object implementing the bracket protocol, see implementsBrackets
+func (i *Interpreter) evaluateMapTarget(expr *expression.MapAccessExpression, assigning bool) (types.Value, types.Value, error) {
+	target, err := i.EvaluateExpression(expr.Map)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Views are accessed like the collection of their matching elements
+	if target, err = i.collectView(target, assigning, expr.GetLocation()); err != nil {
+		return nil, nil, err
+	}
+
+	if !implementsBrackets(target) {
+		// Objects of classes extending Map are accessed like the map they wrap
+		m, ok := types.Unwrap(target).(*types.Map)
+		if !ok {
+			return nil, nil, &RuntimeError{
+				Message:  fmt.Sprintf("Cannot use curly access on %s", target.Type()),
+				Location: expr.GetLocation(),
+			}
+		}
+		target = m
+	}
+
+	key, err := i.EvaluateExpression(expr.Key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return target, key, nil
+}
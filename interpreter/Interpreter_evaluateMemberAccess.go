@@ -0,0 +1,101 @@
+package interpreter
+
+import (
+	"fmt"
+	"zen/lang/common"
+	"zen/lang/parsing/expression"
+	"zen/runtime/types"
+)
+
+// evaluateMemberAccess reads a property or method of a value, e.g. names.length or names.append
+func (i *Interpreter) evaluateMemberAccess(expr *expression.MemberAccessExpression) (types.Value, error) {
+	object, err := i.EvaluateExpression(expr.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	target, ok := object.(types.HasMembers)
+	if !ok {
+		return nil, &RuntimeError{
+			Message:  fmt.Sprintf("Cannot access property '%s' of %s", expr.Property, object.Type()),
+			Location: expr.GetLocation(),
+		}
+	}
+
+	name := i.memberName(expr)
+	if err := i.checkAccess(object, name, expr.GetLocation()); err != nil {
+		return nil, err
+	}
+
+	member, err := target.GetMember(name)
+	if err != nil {
+		return nil, &RuntimeError{
+			Message:  err.Error(),
+			Location: expr.GetLocation(),
+		}
+	}
+	return member, nil
+}
+
+// assignMember assigns a value to a property of a value, e.g. this.name = name
+func (i *Interpreter) assignMember(target *expression.MemberAccessExpression, value types.Value) error {
+	object, err := i.EvaluateExpression(target.Object)
+	if err != nil {
+		return err
+	}
+
+	mutable, ok := object.(types.HasMutableMembers)
+	if !ok {
+		return &RuntimeError{
+			Message:  fmt.Sprintf("Cannot assign to property '%s' of %s", target.Property, object.Type()),
+			Location: target.GetLocation(),
+		}
+	}
+
+	name := i.memberName(target)
+	if err := i.checkAccess(object, name, target.GetLocation()); err != nil {
+		return err
+	}
+
+	if err := mutable.SetMember(name, value); err != nil {
+		return &RuntimeError{
+			Message:  err.Error(),
+			Location: target.GetLocation(),
+		}
+	}
+	return nil
+}
+
+// memberName returns the name of the member accessed by a member access expression.
+// Within methods of a trait, this.member refers to the name the member was renamed to by the class using the trait
+func (i *Interpreter) memberName(expr *expression.MemberAccessExpression) string {
+	if this, ok := expr.Object.(*expression.IdentifierExpression); ok && this.Name == "this" {
+		if alias, ok := i.memberAliases[expr.Property]; ok {
+			return alias
+		}
+	}
+	return expr.Property
+}
+
+// checkAccess returns an error if a private or protected member of an object or super
+// can't be accessed from the function being executed, see types.CanAccess
+func (i *Interpreter) checkAccess(value types.Value, name string, location *common.SourceLocation) error {
+	var class *types.Class
+	switch target := value.(type) {
+	case *types.Object:
+		class = target.Class
+	case *types.SuperReference:
+		class = target.Class
+	default:
+		return nil
+	}
+
+	visibility, owner := class.MemberVisibility(name)
+	if types.CanAccess(i.currentClass, visibility, owner) {
+		return nil
+	}
+	return &RuntimeError{
+		Message:  fmt.Sprintf("Cannot access %s member '%s' of class '%s'", visibility, name, owner.Name),
+		Location: location,
+	}
+}
@@ -0,0 +1,41 @@
+package interpreter
+
+import (
+	"fmt"
+	"zen/lang/parsing/ast"
+	"zen/lang/parsing/expression"
+	"zen/runtime/types"
+)
+
+// evaluateRange creates a lazy range of integers, e.g. 3..5
+func (i *Interpreter) evaluateRange(expr *expression.RangeExpression) (types.Value, error) {
+	start, err := i.evaluateRangeBound(expr.Start, "start")
+	if err != nil {
+		return nil, err
+	}
+	end, err := i.evaluateRangeBound(expr.End, "end")
+	if err != nil {
+		return nil, err
+	}
+	return types.NewRange(start, end), nil
+}
+
+// evaluateRangeBound evaluates the start or end of a range, which must be an integer
+func (i *Interpreter) evaluateRangeBound(expr ast.Expression, name string) (int64, error) {
+	value, err := i.EvaluateExpression(expr)
+	if err != nil {
+		return 0, err
+	}
+
+	switch v := value.(type) {
+	case *types.Int:
+		return int64(v.Value()), nil
+	case *types.Int64:
+		return v.Value(), nil
+	default:
+		return 0, &RuntimeError{
+			Message:  fmt.Sprintf("Range %s must be an integer, got %s", name, value.Type()),
+			Location: expr.GetLocation(),
+		}
+	}
+}
@@ -0,0 +1,259 @@
+package interpreter
+
+import (
+	"fmt"
+	"zen/lang/common"
+	"zen/lang/parsing/ast"
+	"zen/lang/parsing/expression"
+	"zen/lang/parsing/statement"
+	"zen/runtime/async"
+	"zen/runtime/errors"
+	"zen/runtime/types"
+)
+
+// evaluateSpawn calls a function on a new isolate, which runs in parallel on its own goroutine with its own interpreter and event loop,
+// and evaluates to a promise of its result, e.g. const total = await spawn sum(1, 100).
+// Only functions declared at the top level of a file can be spawned: the isolate executes the declarations of the file again,
+// so the function sees its own copy of them, and the constants of the file with a shareable value.
+// The same goes for the modules the file imports, whose other top-level statements aren't executed again, see loadModule.
+// Arguments and the result must be shareable, see types.IsShareable, so isolates only communicate through channels
+func (i *Interpreter) evaluateSpawn(expr *expression.SpawnExpression) (types.Value, error) {
+	call := expr.Call
+	callee, err := i.EvaluateExpression(call.Callee)
+	if err != nil {
+		return nil, err
+	}
+
+	fn, module := i.spawnableFunction(callee)
+	if module == nil {
+		return nil, &RuntimeError{
+			Message:  fmt.Sprintf("Cannot spawn %s, only functions declared at the top level of a file can be spawned", types.Inspect(callee)),
+			Location: call.Callee.GetLocation(),
+		}
+	}
+	if len(call.TypeArguments) > 0 {
+		return nil, &RuntimeError{
+			Message:  fmt.Sprintf("Cannot pass type arguments to spawned function '%s', they are inferred from the arguments", fn.Name),
+			Location: expr.GetLocation(),
+		}
+	}
+
+	args := make([]types.Value, len(call.Arguments))
+	for idx, argExpr := range call.Arguments {
+		arg, err := i.EvaluateExpression(argExpr)
+		if err != nil {
+			return nil, err
+		}
+		if !types.IsShareable(arg) {
+			return nil, &RuntimeError{
+				Message:  fmt.Sprintf("Cannot pass %s to spawned function '%s', only immutable values and channels can be shared between isolates", arg.Type(), fn.Name),
+				Location: argExpr.GetLocation(),
+			}
+		}
+		args[idx] = arg
+	}
+
+	constants, err := sharedConstants(module)
+	if err != nil {
+		return nil, &RuntimeError{
+			Message:  err.Error(),
+			Location: expr.GetLocation(),
+		}
+	}
+	modules := make(map[string]map[string]types.Value, len(i.modules))
+	for path, loaded := range i.modules {
+		if modules[path], err = sharedConstants(loaded); err != nil {
+			return nil, &RuntimeError{
+				Message:  err.Error(),
+				Location: expr.GetLocation(),
+			}
+		}
+	}
+
+	isolate := NewInterpreter()
+	isolate.spawnedModules = modules
+	isolate.loop = i.loop.Fork()
+	isolate.callStack = append([]*CallFrame{}, i.callStack...)
+
+	promise := i.loop.NewPromise()
+	i.loop.Expect()
+	go func() {
+		result, err := isolate.runSpawned(module, fn.Name, args, constants, expr.GetLocation())
+		err = isolate.isolateError(err)
+		// The result is posted before the isolate exits, so the group never sees it blocked waiting for a result that is on its way
+		i.loop.Post(func() {
+			if err != nil {
+				promise.Reject(err)
+				return
+			}
+			promise.Resolve(result)
+		})
+		isolate.loop.Exit()
+	}()
+	return promise, nil
+}
+
+// spawnableFunction returns a zen function declared at the top level of the program or of a module, and the module declaring it.
+// The module is nil for any other value, including methods, lambdas and functions declared within a function or block
+func (i *Interpreter) spawnableFunction(callee types.Value) (*types.UserFunction, *Module) {
+	fn, ok := callee.(*types.UserFunction)
+	if !ok || fn.Lambda || fn.Owner != nil {
+		return nil, nil
+	}
+
+	candidates := []*Module{i.main}
+	for _, module := range i.modules {
+		candidates = append(candidates, module)
+	}
+	for _, module := range candidates {
+		if module == nil || module.Scope != fn.Closure {
+			continue
+		}
+		for _, stmt := range module.Program.Statements {
+			if declaration, ok := stmt.(*statement.FuncDeclaration); ok && declaration.Name == fn.Name {
+				return fn, module
+			}
+		}
+	}
+	return nil, nil
+}
+
+// sharedConstants returns the top-level constants of a module whose value is shareable, which a spawned isolate defines as well
+func sharedConstants(module *Module) (map[string]types.Value, error) {
+	constants := make(map[string]types.Value)
+	for _, name := range module.Scope.Names() {
+		info, _ := module.Scope.GetLocal(name)
+		if !info.IsConstant() {
+			continue
+		}
+		value, err := types.FromGoValue(info.Value())
+		if err != nil {
+			return nil, err
+		}
+		if types.IsShareable(value) {
+			constants[name] = value
+		}
+	}
+	return constants, nil
+}
+
+// runSpawned runs the main task of a spawned isolate, which executes the declarations of the module declaring the spawned function
+// and calls it, awaiting its result if it's async. The loop runs until the tasks the function started have completed as well, see Execute
+func (i *Interpreter) runSpawned(module *Module, name string, args []types.Value, constants map[string]types.Value, location *common.SourceLocation) (types.Value, error) {
+	var result types.Value
+	var err error
+	main := async.NewTask(name, func(task *async.Task) {
+		i.task = task
+		result, err = i.callSpawned(module, name, args, constants, location)
+	})
+
+	unhandled := i.loop.Run(main)
+	if err != nil && err != async.ErrAborted {
+		return nil, err
+	}
+	if unhandled != nil {
+		return nil, i.attachStack(unhandled)
+	}
+	return result, nil
+}
+
+// callSpawned defines the constants and declarations of a module in the top-level scope of a spawned isolate, then calls the spawned function.
+// Other top-level statements of the module are not executed again
+func (i *Interpreter) callSpawned(module *Module, name string, args []types.Value, constants map[string]types.Value, location *common.SourceLocation) (types.Value, error) {
+	i.main = &Module{Name: module.Name, Path: module.Path, Scope: i.env.CurrentScope(), Program: module.Program}
+
+	if err := i.executeDeclarations(module.Program, constants, location); err != nil {
+		return nil, i.attachStack(err)
+	}
+
+	declared, err := i.env.Get(name)
+	if err != nil {
+		return nil, &RuntimeError{
+			Message:  err.Error(),
+			Location: location,
+		}
+	}
+	fn, err := types.FromGoValue(declared)
+	if err != nil {
+		return nil, &RuntimeError{
+			Message:  err.Error(),
+			Location: location,
+		}
+	}
+
+	result, err := i.callFunction(fn, args, nil, location)
+	if err != nil {
+		return nil, i.attachStack(err)
+	}
+	if promise, ok := result.(*async.Promise); ok {
+		if result, err = i.task.Await(promise); err != nil {
+			return nil, err
+		}
+		if result == nil {
+			result = types.NewNull()
+		}
+	}
+
+	if !types.IsShareable(result) {
+		return nil, &RuntimeError{
+			Message:  fmt.Sprintf("Cannot return %s from spawned function '%s', only immutable values and channels can be shared between isolates", result.Type(), name),
+			Location: location,
+		}
+	}
+	return result, nil
+}
+
+// executeDeclarations defines the given constants of a file, then executes its declarations and imports in the current scope.
+// A spawned isolate executes the files the isolate that spawned it has executed this way, see sharedConstants
+func (i *Interpreter) executeDeclarations(program *ast.ProgramNode, constants map[string]types.Value, location *common.SourceLocation) error {
+	for constant, value := range constants {
+		if err := i.env.DefineConst(constant, types.ToGoValue(value)); err != nil {
+			return &RuntimeError{
+				Message:  err.Error(),
+				Location: location,
+			}
+		}
+	}
+	for _, stmt := range program.Statements {
+		switch stmt.(type) {
+		case *statement.FuncDeclaration, *statement.ClassDeclaration, *statement.InterfaceDeclaration,
+			*statement.TraitDeclaration, *statement.ImportStatement:
+			if err := i.ExecuteStatement(stmt); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// isolateError converts an error a spawned isolate fails with to one the isolate that spawned it can raise.
+// Exception objects belong to the isolate that threw them, so built-in exceptions become a runtime error raised as the same class,
+// and exceptions of other classes a RuntimeException with the name of their class in the message
+func (i *Interpreter) isolateError(err error) error {
+	switch e := err.(type) {
+	case *errors.Exception:
+		name := e.Object.Class.Name
+		if i.exceptionClasses[name] == e.Object.Class {
+			return &RuntimeError{
+				Message:   e.Message(),
+				Location:  e.Location,
+				Exception: name,
+				Stack:     e.Stack,
+			}
+		}
+		return &RuntimeError{
+			Message:  fmt.Sprintf("%s: %s", e.Object.TypeName(), e.Message()),
+			Location: e.Location,
+			Stack:    e.Stack,
+		}
+	case nil:
+		return nil
+	}
+	if err == async.ErrStalled {
+		return &RuntimeError{Message: "Awaited promise never settles"}
+	}
+	if err == async.ErrDeadlock {
+		return &RuntimeError{Message: err.Error()}
+	}
+	return err
+}
@@ -0,0 +1,240 @@
+package interpreter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"zen/lang/common"
+	"zen/lang/parsing/ast"
+	"zen/lang/parsing/expression"
+	"zen/runtime/environment"
+	"zen/runtime/types"
+)
+
+// evaluateWhere filters an array or map lazily, e.g. people where person{"age"} > 20, see types.View.
+// Within the condition, 'value' refers to the current element and 'index' (arrays) or 'key' (maps) to its position.
+// A condition that doesn't use them names the element with an identifier that isn't defined, see whereElement.
+// A lambda condition names them itself instead, e.g. people where { person -> person{"age"} > 20 },
+// taking the element, or its index or key and the element.
+// The condition is evaluated in the scope the where expression is evaluated in, whenever the view is used
+func (i *Interpreter) evaluateWhere(expr *expression.WhereExpression) (types.Value, error) {
+	collection, err := i.EvaluateExpression(expr.Collection)
+	if err != nil {
+		return nil, err
+	}
+
+	source := types.Unwrap(collection)
+	keyName := "index"
+	switch s := source.(type) {
+	case *types.Array:
+	case *types.Map:
+		keyName = "key"
+	case *types.View:
+		if s.IsMap() {
+			keyName = "key"
+		}
+	default:
+		return nil, &RuntimeError{
+			Message:  fmt.Sprintf("Cannot filter %s with 'where', expected an Array or Map", collection.Type()),
+			Location: expr.Collection.GetLocation(),
+		}
+	}
+
+	if lambda, ok := expr.Condition.(*expression.LambdaExpression); ok {
+		return i.lambdaView(source, lambda)
+	}
+
+	element, err := i.whereElement(expr.Condition)
+	if err != nil {
+		return nil, err
+	}
+
+	scope := i.env.CurrentScope()
+	return types.NewView(source, func(key, value types.Value) (bool, error) {
+		return i.inScope(scope, func() (bool, error) {
+			return i.matchesCondition(expr.Condition, func() error {
+				if err := i.env.DefineConst(keyName, types.ToGoValue(key)); err != nil {
+					return err
+				}
+				if element != "" {
+					if err := i.env.DefineNullable(element, types.ToGoValue(value)); err != nil {
+						return err
+					}
+				}
+				return i.env.DefineNullable("value", types.ToGoValue(value))
+			})
+		})
+	}), nil
+}
+
+// whereElement returns the name a where condition gives the element, e.g. person in people where person{"age"} > 20,
+// which is the one identifier of the condition that isn't defined. It's empty for conditions using value, index or key,
+// in which any undefined identifier is an error when the condition is evaluated, so a misspelled variable isn't taken for the element.
+// A condition referring to neither is an error, as the identifier meant to name the element is a variable that is already defined
+func (i *Interpreter) whereElement(condition ast.Expression) (string, error) {
+	usesElement := false
+	undefined := make(map[string]bool)
+	collectIdentifiers(condition, func(name string) {
+		if name == "value" || name == "index" || name == "key" {
+			usesElement = true
+		} else if _, err := i.env.Get(name); err != nil {
+			undefined[name] = true
+		}
+	})
+	if usesElement {
+		return "", nil
+	}
+
+	names := make([]string, 0, len(undefined))
+	for name := range undefined {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	switch len(names) {
+	case 1:
+		return names[0], nil
+	case 0:
+		return "", &RuntimeError{
+			Message:  "Where condition doesn't refer to the element, name it with an identifier that isn't defined, or use value, index or key",
+			Location: condition.GetLocation(),
+		}
+	default:
+		return "", &RuntimeError{
+			Message:  fmt.Sprintf("Ambiguous element in where condition, found undefined identifiers %s", strings.Join(names, ", ")),
+			Location: condition.GetLocation(),
+		}
+	}
+}
+
+// collectIdentifiers calls fn for every identifier referenced by an expression.
+// Lambdas and the conditions of nested where expressions aren't visited, as they name their own elements
+func collectIdentifiers(expr ast.Expression, fn func(name string)) {
+	switch e := expr.(type) {
+	case *expression.IdentifierExpression:
+		fn(e.Name)
+	case *expression.BinaryExpression:
+		collectIdentifiers(e.Left, fn)
+		collectIdentifiers(e.Right, fn)
+	case *expression.UnaryExpression:
+		collectIdentifiers(e.Expression, fn)
+	case *expression.PostfixExpression:
+		collectIdentifiers(e.Operand, fn)
+	case *expression.CallExpression:
+		collectIdentifiers(e.Callee, fn)
+		for _, arg := range e.Arguments {
+			collectIdentifiers(arg, fn)
+		}
+	case *expression.MemberAccessExpression:
+		collectIdentifiers(e.Object, fn)
+	case *expression.ArrayAccessExpression:
+		collectIdentifiers(e.Array, fn)
+		collectIdentifiers(e.Index, fn)
+	case *expression.MapAccessExpression:
+		collectIdentifiers(e.Map, fn)
+		collectIdentifiers(e.Key, fn)
+	case *expression.RangeExpression:
+		collectIdentifiers(e.Start, fn)
+		collectIdentifiers(e.End, fn)
+	case *expression.IsTypeExpression:
+		collectIdentifiers(e.Value, fn)
+	case *expression.HasExpression:
+		collectIdentifiers(e.Value, fn)
+	case *expression.ArrayLiteralExpression:
+		for _, element := range e.Elements {
+			collectIdentifiers(element, fn)
+		}
+	case *expression.TupleExpression:
+		for _, element := range e.Elements {
+			collectIdentifiers(element, fn)
+		}
+	case *expression.MapLiteralExpression:
+		for _, entry := range e.Entries {
+			collectIdentifiers(entry.Key, fn)
+			collectIdentifiers(entry.Value, fn)
+		}
+	case *expression.WhereExpression:
+		collectIdentifiers(e.Collection, fn)
+	}
+}
+
+// lambdaView creates the view of a where expression whose condition is a lambda, which is called for each element
+func (i *Interpreter) lambdaView(source types.Value, lambda *expression.LambdaExpression) (types.Value, error) {
+	if len(lambda.Parameters) < 1 || len(lambda.Parameters) > 2 {
+		return nil, &RuntimeError{
+			Message:  fmt.Sprintf("A where lambda takes the element, or its index or key and the element, got %d parameters", len(lambda.Parameters)),
+			Location: lambda.GetLocation(),
+		}
+	}
+
+	fn, err := i.evaluateLambda(lambda)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewView(source, func(key, value types.Value) (bool, error) {
+		args := []types.Value{value}
+		if len(lambda.Parameters) == 2 {
+			args = []types.Value{key, value}
+		}
+		result, err := i.callFunction(fn, args, nil, lambda.GetLocation())
+		if err != nil {
+			return false, err
+		}
+		return whereResult(result, lambda)
+	}), nil
+}
+
+// inScope evaluates fn with the given scope as the current scope, e.g. the scope a where expression was evaluated in
+func (i *Interpreter) inScope(scope *environment.Scope, fn func() (bool, error)) (bool, error) {
+	previous := i.env.BeginScopeFrom(scope)
+	defer i.env.RestoreScope(previous)
+	return fn()
+}
+
+// matchesCondition evaluates a where condition for one element, in a new scope in which bind defines the element's variables
+func (i *Interpreter) matchesCondition(condition ast.Expression, bind func() error) (bool, error) {
+	i.env.BeginScope()
+	defer i.env.EndScope()
+
+	if err := bind(); err != nil {
+		return false, &RuntimeError{
+			Message:  err.Error(),
+			Location: condition.GetLocation(),
+		}
+	}
+
+	result, err := i.EvaluateExpression(condition)
+	if err != nil {
+		return false, err
+	}
+	return whereResult(result, condition)
+}
+
+// whereResult returns whether an element matches a where condition that evaluated to result, which must be a boolean
+func whereResult(result types.Value, condition ast.Expression) (bool, error) {
+	if result.Type() != types.TypeBool {
+		return false, &RuntimeError{
+			Message:  fmt.Sprintf("Where condition must be a boolean, got %s", result.Type()),
+			Location: condition.GetLocation(),
+		}
+	}
+	return result.IsTruthy(), nil
+}
+
+// collectView returns the collection of matching elements of a view, e.g. to index into it, or the value itself otherwise.
+// Assigning an element of a view is an error, as it would only change the collected elements, not the collection the view filters
+func (i *Interpreter) collectView(value types.Value, assigning bool, location *common.SourceLocation) (types.Value, error) {
+	if view, ok := value.(*types.View); ok && assigning {
+		return nil, &RuntimeError{
+			Message:  fmt.Sprintf("Cannot assign elements of a view of %s, a view only reads the collection it filters", view.Collection().Type()),
+			Location: location,
+		}
+	}
+	collected, err := types.CollectView(value)
+	if typeErr, ok := err.(*types.TypeError); ok {
+		return nil, &RuntimeError{
+			Message:  typeErr.Error(),
+			Location: location,
+		}
+	}
+	return collected, err
+}
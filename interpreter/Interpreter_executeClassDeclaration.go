@@ -0,0 +1,647 @@
+package interpreter
+
+import (
+	"fmt"
+	"strings"
+	"zen/lang/common"
+	"zen/lang/parsing/ast"
+	"zen/lang/parsing/expression"
+	"zen/lang/parsing/statement"
+	"zen/runtime/types"
+)
+
+// executeClassDeclaration creates a class and binds it to its name in the current scope
+// The class is defined before its fields are resolved, so fields can refer to the class itself, e.g. next: Node?
+// The superclass is resolved before that, so a class can't extend itself.
+// Once the members declared by the class and its traits are added, the class is verified to satisfy the interfaces it implements.
+// The members of generic classes are declared in a scope defining their type parameters, see declareTypeParameters.
+// Members declared by files included in the class body are added first, see includeClassMembers
+func (i *Interpreter) executeClassDeclaration(stmt *statement.ClassDeclaration) error {
+	stmt, err := i.includeClassMembers(stmt, nil)
+	if err != nil {
+		return err
+	}
+
+	scope := i.env.CurrentScope()
+	class := types.NewClass(stmt.Name, scope)
+
+	if len(stmt.TypeParameters) > 0 {
+		params, closure, err := i.declareTypeParameters(stmt.Name, stmt.TypeParameters)
+		if err != nil {
+			return err
+		}
+		class.TypeParameters, class.Closure = params, closure
+
+		previous := i.env.BeginScopeFrom(closure)
+		defer i.env.RestoreScope(previous)
+	}
+
+	if stmt.Superclass != nil {
+		if err := i.resolveSuperclass(class, stmt.Superclass); err != nil {
+			return err
+		}
+	}
+
+	for _, ifaceType := range stmt.Interfaces {
+		hint, err := i.resolveTypeHint(ifaceType, false)
+		if err != nil {
+			return err
+		}
+		if hint.Interface == nil {
+			return &RuntimeError{
+				Message:  fmt.Sprintf("Class '%s' cannot implement %s, expected an interface", stmt.Name, hint),
+				Location: ifaceType.GetLocation(),
+			}
+		}
+		class.Interfaces = append(class.Interfaces, hint.Interface)
+	}
+
+	if err := scope.DefineConst(stmt.Name, class); err != nil {
+		return &RuntimeError{
+			Message:  err.Error(),
+			Location: stmt.GetLocation(),
+		}
+	}
+
+	for _, field := range stmt.Fields {
+		if class.Superclass != nil && class.Superclass.HasMember(field.Name) {
+			return &RuntimeError{
+				Message:  fmt.Sprintf("Field '%s' of class '%s' is already declared in superclass '%s'", field.Name, stmt.Name, class.Superclass.Name),
+				Location: field.GetLocation(),
+			}
+		}
+		if class.HasMember(field.Name) {
+			return &RuntimeError{
+				Message:  fmt.Sprintf("Field '%s' is already declared in class '%s'", field.Name, stmt.Name),
+				Location: field.GetLocation(),
+			}
+		}
+
+		hint, err := i.resolveTypeHint(field.Type, field.IsNullable)
+		if err != nil {
+			return err
+		}
+		var typeExpr ast.Expression
+		if len(class.TypeParameters) > 0 {
+			typeExpr = field.Type
+		}
+		class.Fields = append(class.Fields, &types.ClassField{
+			Name:           field.Name,
+			Type:           hint,
+			TypeExpression: typeExpr,
+			Default:        field.Initializer,
+			Constant:       field.IsConstant,
+			Owner:          class,
+			Visibility:     field.Visibility,
+			Closure:        class.Closure,
+			Location:       field.GetLocation(),
+		})
+	}
+
+	for _, method := range stmt.Methods {
+		fn := types.NewUserFunction(stmt.Name+"."+method.Name, method.Parameters, method.ReturnType, method.Body, method.Async, class.Closure)
+		fn.Owner = class
+		fn.Visibility = method.Visibility
+		if len(method.TypeParameters) > 0 {
+			params, closure, err := i.declareTypeParameters(fn.Name, method.TypeParameters)
+			if err != nil {
+				return err
+			}
+			fn.TypeParameters, fn.Closure = params, closure
+		}
+
+		if method.Name == "init" {
+			class.Constructors = append(class.Constructors, fn)
+			continue
+		}
+
+		if class.Superclass != nil {
+			if class.Superclass.GetField(method.Name) != nil {
+				return &RuntimeError{
+					Message:  fmt.Sprintf("Method '%s' of class '%s' conflicts with a field of superclass '%s'", method.Name, stmt.Name, class.Superclass.Name),
+					Location: method.GetLocation(),
+				}
+			}
+			if overridden := class.Superclass.FindMethod(method.Name); overridden != nil {
+				if err := i.checkOverride(fn, overridden, method.GetLocation()); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Inherited fields are ruled out above, so any field or method found here is declared by this class
+		if _, ok := class.Methods[method.Name]; ok || class.GetField(method.Name) != nil {
+			return &RuntimeError{
+				Message:  fmt.Sprintf("Member '%s' is already declared in class '%s'", method.Name, stmt.Name),
+				Location: method.GetLocation(),
+			}
+		}
+		class.Methods[method.Name] = fn
+	}
+
+	provided := make(map[string]string)
+	for _, use := range stmt.Uses {
+		if err := i.useTrait(class, use, provided); err != nil {
+			return err
+		}
+	}
+
+	for idx, iface := range class.Interfaces {
+		mismatch, err := i.interfaceMismatch(class, iface, "")
+		if err != nil {
+			return err
+		}
+		if mismatch != "" {
+			return &RuntimeError{
+				Message:  fmt.Sprintf("Class '%s' does not implement interface '%s': %s", stmt.Name, iface.FullName(), mismatch),
+				Location: stmt.Interfaces[idx].GetLocation(),
+			}
+		}
+	}
+
+	if err := i.checkPrimaryConstructor(stmt); err != nil {
+		return err
+	}
+	if stmt.Visibility == ast.Private {
+		if err := scope.SetPrivate(stmt.Name); err != nil {
+			return &RuntimeError{
+				Message:  err.Error(),
+				Location: stmt.GetLocation(),
+			}
+		}
+	}
+	return nil
+}
+
+// resolveSuperclass sets the class a class extends, which is either another class or a built-in Array or Map type.
+// Instances of classes extending Array or Map wrap a native value of that type, see types.Object
+func (i *Interpreter) resolveSuperclass(class *types.Class, superclass ast.Expression) error {
+	hint, err := i.resolveTypeHint(superclass, false)
+	if err != nil {
+		return err
+	}
+
+	class.Extends = superclass
+	switch {
+	case hint.Class != nil && !hint.Nullable:
+		if len(hint.Class.TypeParameters) > 0 && len(hint.TypeArguments) == 0 {
+			return &RuntimeError{
+				Message:  fmt.Sprintf("Class '%s' must give the type arguments of generic class '%s', e.g. %s<...>", class.Name, hint.Class.Name, hint.Class.Name),
+				Location: superclass.GetLocation(),
+			}
+		}
+		class.Superclass = hint.Class
+		class.Native = hint.Class.Native
+	case (hint.Name == "Array" || hint.Name == "Map") && !hint.Nullable:
+		class.Native = hint
+	default:
+		return &RuntimeError{
+			Message:  fmt.Sprintf("Class '%s' cannot extend %s, expected a class, Array or Map", class.Name, hint),
+			Location: superclass.GetLocation(),
+		}
+	}
+	return nil
+}
+
+// checkOverride verifies that a method overriding a method of a superclass keeps a compatible signature,
+// see compatibleSignature, and is at least as visible. Private methods can't be overridden
+func (i *Interpreter) checkOverride(method *types.UserFunction, overridden *types.UserFunction, location *common.SourceLocation) error {
+	if overridden.Visibility == ast.Private {
+		return &RuntimeError{
+			Message:  fmt.Sprintf("Method '%s' cannot override private method '%s'", method.Name, overridden.Name),
+			Location: location,
+		}
+	}
+	if method.Visibility.IsNarrowerThan(overridden.Visibility) {
+		return &RuntimeError{
+			Message: fmt.Sprintf("Method '%s' cannot be less visible than '%s' it overrides: expected %s, got %s",
+				method.Name, overridden.Name, overridden.Visibility, method.Visibility),
+			Location: location,
+		}
+	}
+
+	signature, err := i.resolveSignature(method)
+	if err != nil {
+		return err
+	}
+	expected, err := i.resolveSignature(overridden)
+	if err != nil {
+		return err
+	}
+
+	if !compatibleSignature(signature, expected) {
+		return &RuntimeError{
+			Message: fmt.Sprintf("Method '%s' must have a signature compatible with '%s' it overrides: expected %s, got %s",
+				method.Name, overridden.Name, expected, signature),
+			Location: location,
+		}
+	}
+	return nil
+}
+
+// compatibleSignature returns true if a method with the given function type can be used in place of the expected one:
+// the parameter types must be the same, and the return type the same or a subclass of the expected return type.
+// The type parameters of a generic class stand for any type, so a method of a class extending Box<int> can take an int for a T
+func compatibleSignature(signature *types.TypeHint, expected *types.TypeHint) bool {
+	if len(signature.Parameters) != len(expected.Parameters) {
+		return false
+	}
+	for idx, param := range signature.Parameters {
+		if param.String() != expected.Parameters[idx].String() && expected.Parameters[idx].Parameter == nil {
+			return false
+		}
+	}
+	if signature.Returns.String() == expected.Returns.String() || expected.Returns.Parameter != nil {
+		return true
+	}
+
+	returns, expectedReturns := signature.Returns, expected.Returns
+	return returns.Class != nil && expectedReturns.Class != nil &&
+		returns.Class.IsSubclassOf(expectedReturns.Class) && (expectedReturns.Nullable || !returns.Nullable)
+}
+
+// resolveSignature returns the function type of a function, resolving its parameter and return types
+// in the scope it was declared in
+func (i *Interpreter) resolveSignature(fn *types.UserFunction) (*types.TypeHint, error) {
+	previous := i.env.BeginScopeFrom(fn.Closure)
+	defer i.env.RestoreScope(previous)
+
+	return i.resolveFunctionType(fn.Parameters, fn.ReturnType)
+}
+
+// resolveFunctionType returns the function type of the parameters and return type of a function declaration.
+// A missing return type is void
+func (i *Interpreter) resolveFunctionType(parameters []expression.FuncParameterExpression, returnType ast.Expression) (*types.TypeHint, error) {
+	params := make([]*types.TypeHint, len(parameters))
+	for idx, param := range parameters {
+		hint, err := i.resolveTypeHint(param.Type, param.IsNullable)
+		if err != nil {
+			return nil, err
+		}
+		params[idx] = hint
+	}
+
+	returns := types.NewTypeHint("void", false)
+	if returnType != nil {
+		hint, err := i.resolveTypeHint(returnType, false)
+		if err != nil {
+			return nil, err
+		}
+		returns = hint
+	}
+	return types.NewFunctionTypeHint(params, returns, false), nil
+}
+
+// checkPrimaryConstructor verifies that a class with init constructors has exactly one primary constructor.
+// All other constructors are auxiliary and must chain to another constructor with this.init(...),
+// so that the initialization logic always runs through the primary constructor
+func (i *Interpreter) checkPrimaryConstructor(stmt *statement.ClassDeclaration) error {
+	var constructors, primaries []*statement.FuncDeclaration
+	for _, method := range stmt.Methods {
+		if method.Name != "init" {
+			continue
+		}
+		constructors = append(constructors, method)
+		if !chainsConstructor(method) {
+			primaries = append(primaries, method)
+		}
+	}
+
+	if len(constructors) == 0 || len(primaries) == 1 {
+		return nil
+	}
+
+	location := stmt.GetLocation()
+	if len(primaries) > 1 {
+		location = primaries[1].GetLocation()
+	}
+	return &RuntimeError{
+		Message: fmt.Sprintf("Class '%s' must have exactly one primary constructor, found %d. Other init constructors must call this.init(...)",
+			stmt.Name, len(primaries)),
+		Location: location,
+	}
+}
+
+// chainsConstructor returns true if the body of a constructor calls this.init(...)
+func chainsConstructor(constructor *statement.FuncDeclaration) bool {
+	for _, stmt := range constructor.Body {
+		exprStmt, ok := stmt.(*statement.ExpressionStatement)
+		if !ok {
+			continue
+		}
+		call, ok := exprStmt.Expression.(*expression.CallExpression)
+		if !ok {
+			continue
+		}
+		callee, ok := call.Callee.(*expression.MemberAccessExpression)
+		if !ok || callee.Property != "init" {
+			continue
+		}
+		if this, ok := callee.Object.(*expression.IdentifierExpression); ok && this.Name == "this" {
+			return true
+		}
+	}
+	return false
+}
+
+// evaluateNew creates an instance of a class, e.g. new Person("john")
+func (i *Interpreter) evaluateNew(expr *expression.NewExpression) (types.Value, error) {
+	if identifier, ok := expr.Class.(*expression.IdentifierExpression); ok && identifier.Name == "Channel" {
+		if _, isClass := i.lookupClass(identifier.Name); !isClass {
+			return i.newChannel(expr)
+		}
+	}
+
+	value, err := i.EvaluateExpression(expr.Class)
+	if err != nil {
+		return nil, err
+	}
+
+	class, ok := value.(*types.Class)
+	if !ok {
+		return nil, &RuntimeError{
+			Message:  fmt.Sprintf("Cannot use 'new' with %s, expected a class", value.Type()),
+			Location: expr.GetLocation(),
+		}
+	}
+
+	args := make([]types.Value, len(expr.Arguments))
+	for idx, argExpr := range expr.Arguments {
+		arg, err := i.EvaluateExpression(argExpr)
+		if err != nil {
+			return nil, err
+		}
+		args[idx] = arg
+	}
+
+	typeArgs, err := i.resolveTypeArguments(expr.TypeArguments)
+	if err != nil {
+		return nil, err
+	}
+	return i.instantiate(class, args, typeArgs, expr.GetLocation())
+}
+
+// instantiate creates an object of a class.
+// Fields are set to their defaults and traits are initialized, then the constructor accepting the arguments is called.
+// Classes without an init constructor use the inherited constructors if they declare no fields,
+// or else the inferred constructor, which assigns the arguments to the inherited and declared fields in order.
+// Objects of generic classes are created with the given type arguments, or else the ones inferred from the arguments
+func (i *Interpreter) instantiate(class *types.Class, args []types.Value, typeArgs []types.Value, location *common.SourceLocation) (types.Value, error) {
+	if len(typeArgs) > 0 && len(class.TypeParameters) == 0 {
+		return nil, &RuntimeError{
+			Message:  fmt.Sprintf("Class '%s' is not generic and takes no type arguments", class.Name),
+			Location: location,
+		}
+	}
+
+	var constructor *types.UserFunction
+	if constructors := class.InitOverloads(); len(constructors) > 0 {
+		selected, err := i.selectOverload(class.Name+".init", constructors, args, location)
+		if err != nil {
+			return nil, err
+		}
+		if !types.CanAccess(i.currentClass, selected.Visibility, selected.Owner) {
+			return nil, &RuntimeError{
+				Message:  fmt.Sprintf("Cannot access %s constructor of class '%s'", selected.Visibility, selected.Owner.Name),
+				Location: location,
+			}
+		}
+		constructor = selected
+	}
+
+	object := types.NewObject(class)
+	if class.IsGeneric() {
+		if len(class.TypeParameters) > 0 && typeArgs == nil {
+			inferred, err := i.inferTypeArguments(class.TypeParameters, constructorParameterTypes(class, constructor), args, location)
+			if err != nil {
+				return nil, err
+			}
+			typeArgs = inferred
+		}
+		if err := i.applyTypeArguments(object, class, typeArgs, location); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := i.initializeFields(object); err != nil {
+		return nil, err
+	}
+	if err := i.initializeTraits(object, class, location); err != nil {
+		return nil, err
+	}
+
+	if constructor != nil {
+		if _, err := i.invokeUserFunction(constructor, object, args, nil, location); err != nil {
+			return nil, err
+		}
+	} else if err := i.inferredConstructor(object, args, location); err != nil {
+		return nil, err
+	}
+
+	// Fields that can't be null must have been given a value by now
+	for _, field := range class.AllFields() {
+		value, _ := object.GetMember(field.Name)
+		hint := object.FieldType(field)
+		if value.Type() == types.TypeNull && !hint.Nullable && hint.Name != "any" {
+			return nil, &RuntimeError{
+				Message:  fmt.Sprintf("Field '%s' of %s must be initialized", field.Name, class.Name),
+				Location: location,
+			}
+		}
+	}
+
+	return object, nil
+}
+
+// constructorParameterTypes returns the declared types of the parameters a class is instantiated with,
+// which are those of the constructor declared by the class, or the types of its fields for the inferred constructor.
+// The type arguments of a generic class are inferred from them, see inferTypeArguments
+func constructorParameterTypes(class *types.Class, constructor *types.UserFunction) []ast.Expression {
+	if constructor != nil {
+		if constructor.Owner != class {
+			return nil
+		}
+		return parameterTypes(constructor)
+	}
+
+	fields := class.AllFields()
+	paramTypes := make([]ast.Expression, len(fields))
+	for idx, field := range fields {
+		paramTypes[idx] = field.TypeExpression
+	}
+	return paramTypes
+}
+
+// initializeFields sets each field of a new object to its default value, or the zero value of its type.
+// Defaults are evaluated in the scope the class or trait declaring the field was declared in
+func (i *Interpreter) initializeFields(object *types.Object) error {
+	for _, field := range object.Class.AllFields() {
+		var value types.Value
+		if field.Default != nil {
+			val, err := i.evaluateFieldDefault(field)
+			if err != nil {
+				return err
+			}
+			value = val
+		} else {
+			value = object.FieldType(field).ZeroValue()
+			if value.Type() == types.TypeNull {
+				// Fields without a zero value stay null until the constructor sets them
+				continue
+			}
+		}
+
+		if err := object.InitField(field, value); err != nil {
+			return &RuntimeError{
+				Message:  err.Error(),
+				Location: field.Location,
+			}
+		}
+	}
+	return nil
+}
+
+// initializeTraits calls the init of each trait used by a class and its superclasses, superclasses first.
+// The arguments of each use are evaluated in the scope the class was declared in
+func (i *Interpreter) initializeTraits(object *types.Object, class *types.Class, location *common.SourceLocation) error {
+	if class.Superclass != nil {
+		if err := i.initializeTraits(object, class.Superclass, location); err != nil {
+			return err
+		}
+	}
+
+	for _, use := range class.Traits {
+		if use.Init == nil {
+			continue
+		}
+
+		args, err := i.evaluateTraitArguments(class, use)
+		if err != nil {
+			return err
+		}
+		if _, err := i.invokeUserFunction(use.Init, object, args, nil, location); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// evaluateTraitArguments evaluates the arguments passed to a trait's init in the scope the class was declared in
+func (i *Interpreter) evaluateTraitArguments(class *types.Class, use *types.TraitUse) ([]types.Value, error) {
+	previous := i.env.BeginScopeFrom(class.Closure)
+	defer i.env.RestoreScope(previous)
+
+	args := make([]types.Value, len(use.Arguments))
+	for idx, argExpr := range use.Arguments {
+		arg, err := i.EvaluateExpression(argExpr)
+		if err != nil {
+			return nil, err
+		}
+		args[idx] = arg
+	}
+	return args, nil
+}
+
+// evaluateFieldDefault evaluates the default value of a field in the scope its class or trait was declared in
+func (i *Interpreter) evaluateFieldDefault(field *types.ClassField) (types.Value, error) {
+	previous := i.env.BeginScopeFrom(field.Closure)
+	defer i.env.RestoreScope(previous)
+
+	return i.EvaluateExpression(field.Default)
+}
+
+// inferredConstructor assigns the arguments to the fields of an object in declaration order, inherited fields first
+func (i *Interpreter) inferredConstructor(object *types.Object, args []types.Value, location *common.SourceLocation) error {
+	fields := object.Class.AllFields()
+	if len(args) > len(fields) {
+		return &RuntimeError{
+			Message:  fmt.Sprintf("Class '%s' expects at most %d argument(s), got %d", object.Class.Name, len(fields), len(args)),
+			Location: location,
+		}
+	}
+
+	for idx, arg := range args {
+		if err := object.InitField(fields[idx], arg); err != nil {
+			return &RuntimeError{
+				Message:  err.Error(),
+				Location: location,
+			}
+		}
+	}
+	return nil
+}
+
+// callMethod calls a method bound to an object, choosing the overload accepting the arguments.
+// typeArgs are the type arguments of a generic method, or nil to infer them
+func (i *Interpreter) callMethod(method *types.BoundMethod, args []types.Value, typeArgs []types.Value, location *common.SourceLocation) (types.Value, error) {
+	fn, err := i.selectOverload(method.Receiver.Class.Name+"."+method.Name, method.Overloads, args, location)
+	if err != nil {
+		return nil, err
+	}
+	return i.invokeUserFunction(fn, method.Receiver, args, typeArgs, location)
+}
+
+// methodCaller returns a types.MethodCaller calling methods from the given location,
+// used by operations on objects implementing operator methods
+func (i *Interpreter) methodCaller(location *common.SourceLocation) types.MethodCaller {
+	return func(method *types.BoundMethod, args []types.Value) (types.Value, error) {
+		return i.callMethod(method, args, nil, location)
+	}
+}
+
+// selectOverload returns the first overload whose parameters accept the arguments.
+// A single overload is always returned, so calling it reports which argument is wrong
+func (i *Interpreter) selectOverload(name string, overloads []*types.UserFunction, args []types.Value, location *common.SourceLocation) (*types.UserFunction, error) {
+	if len(overloads) == 1 {
+		return overloads[0], nil
+	}
+
+	for _, fn := range overloads {
+		accepts, err := i.acceptsArguments(fn, args)
+		if err != nil {
+			return nil, err
+		}
+		if accepts {
+			return fn, nil
+		}
+	}
+
+	argTypes := make([]string, len(args))
+	for idx, arg := range args {
+		argTypes[idx] = arg.Type().String()
+	}
+	return nil, &RuntimeError{
+		Message:  fmt.Sprintf("No overload of '%s' accepts arguments (%s)", name, strings.Join(argTypes, ", ")),
+		Location: location,
+	}
+}
+
+// acceptsArguments returns true if the number and types of the arguments match the parameters of a function.
+// The parameter types are resolved in the scope the function was declared in, where type parameters accept any value
+func (i *Interpreter) acceptsArguments(fn *types.UserFunction, args []types.Value) (bool, error) {
+	if len(args) > len(fn.Parameters) {
+		return false, nil
+	}
+
+	previous := i.env.BeginScopeFrom(fn.Closure)
+	defer i.env.RestoreScope(previous)
+
+	for idx, param := range fn.Parameters {
+		if idx >= len(args) {
+			// Missing arguments are only allowed for parameters with a default or nullable parameters
+			if param.DefaultValue == nil && !param.IsNullable {
+				return false, nil
+			}
+			continue
+		}
+
+		hint, err := i.resolveTypeHint(param.Type, param.IsNullable)
+		if err != nil {
+			return false, err
+		}
+		if !hint.Matches(args[idx]) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
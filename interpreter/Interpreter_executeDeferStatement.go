@@ -0,0 +1,65 @@
+package interpreter
+
+import (
+	"zen/lang/common"
+	"zen/lang/parsing/statement"
+	"zen/runtime/types"
+)
+
+// deferredCall is a call deferred by a defer statement until the enclosing function exits
+type deferredCall struct {
+	// Callee, Arguments and TypeArguments are evaluated by the defer statement, see resolveCall
+	Callee        types.Value
+	Arguments     []types.Value
+	TypeArguments []types.Value
+	Location      *common.SourceLocation
+}
+
+// executeDeferStatement defers a call until the function being executed exits, or the program for top-level defers.
+// Like in Go, the callee and arguments are evaluated right away and the call is made then, e.g. defer file.close(),
+// so later assignments don't change the arguments. Deferred lambdas still see the variables of their block when they run
+func (i *Interpreter) executeDeferStatement(stmt *statement.DeferStatement) error {
+	if len(i.deferred) == 0 {
+		return &RuntimeError{
+			Message:  "defer outside of a function or program",
+			Location: stmt.GetLocation(),
+		}
+	}
+
+	callee, args, typeArgs, err := i.resolveCall(stmt.Call)
+	if err != nil {
+		return err
+	}
+
+	frame := len(i.deferred) - 1
+	i.deferred[frame] = append(i.deferred[frame], &deferredCall{
+		Callee:        callee,
+		Arguments:     args,
+		TypeArguments: typeArgs,
+		Location:      stmt.Call.GetLocation(),
+	})
+	return nil
+}
+
+// beginDeferred starts collecting the calls deferred by a function call or the program
+func (i *Interpreter) beginDeferred() {
+	i.deferred = append(i.deferred, nil)
+}
+
+// runDeferred makes the calls deferred since the matching beginDeferred in reverse order, once the function
+// or program has exited with err, which is nil or the error or exception it exits with.
+// Every deferred call is made, even when an earlier one fails.
+// An error raised by a deferred call replaces err, like one raised in a finally block
+func (i *Interpreter) runDeferred(err error) error {
+	frame := len(i.deferred) - 1
+	calls := i.deferred[frame]
+	i.deferred = i.deferred[:frame]
+
+	for idx := len(calls) - 1; idx >= 0; idx-- {
+		call := calls[idx]
+		if _, callErr := i.callFunction(call.Callee, call.Arguments, call.TypeArguments, call.Location); callErr != nil {
+			err = callErr
+		}
+	}
+	return err
+}
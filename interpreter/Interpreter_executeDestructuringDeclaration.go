@@ -0,0 +1,76 @@
+package interpreter
+
+import (
+	"fmt"
+	"zen/lang/parsing/expression"
+	"zen/lang/parsing/statement"
+	"zen/runtime/types"
+)
+
+// evaluateTuple creates an unnamed tuple of the values returned by a function returning multiple values, e.g. return 404, "not found".
+// The tuple is named after the return type of the function, see types.TypeHint.Names
+func (i *Interpreter) evaluateTuple(expr *expression.TupleExpression) (types.Value, error) {
+	values := make([]types.Value, len(expr.Elements))
+	for idx, element := range expr.Elements {
+		value, err := i.EvaluateExpression(element)
+		if err != nil {
+			return nil, err
+		}
+		values[idx] = value
+	}
+	return types.NewTuple(nil, values), nil
+}
+
+// executeDestructuringDeclaration declares a variable or constant for each element of a tuple, e.g. var statusCode, body <= httpRequest().
+// Variables take on the declared type of their element, elements unpacked into _ are skipped
+func (i *Interpreter) executeDestructuringDeclaration(stmt *statement.DestructuringDeclaration) error {
+	value, err := i.EvaluateExpression(stmt.Initializer)
+	if err != nil {
+		return err
+	}
+
+	tuple, ok := value.(*types.Tuple)
+	if !ok {
+		return &RuntimeError{
+			Message:  fmt.Sprintf("Cannot destructure %s, expected a tuple", value.Type()),
+			Location: stmt.Initializer.GetLocation(),
+		}
+	}
+	if tuple.Length() != len(stmt.Names) {
+		return &RuntimeError{
+			Message:  fmt.Sprintf("Cannot destructure %d values into %d variables", tuple.Length(), len(stmt.Names)),
+			Location: stmt.GetLocation(),
+		}
+	}
+
+	for idx, name := range stmt.Names {
+		if name == "_" {
+			continue
+		}
+
+		element := types.ToGoValue(tuple.Values()[idx])
+		hint := tuple.ElementType(idx)
+		if stmt.IsConstant {
+			err = i.env.DefineConst(name, element)
+		} else if hint != nil && hint.Nullable {
+			err = i.env.DefineNullable(name, element)
+		} else {
+			err = i.env.Define(name, element)
+		}
+
+		if err == nil && hint != nil {
+			err = i.env.SetTypeHint(name, hint)
+		}
+		if err != nil {
+			return &RuntimeError{
+				Message:  err.Error(),
+				Location: stmt.GetLocation(),
+			}
+		}
+
+		if err := i.declareVisibility(name, stmt.Visibility, stmt.GetLocation()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
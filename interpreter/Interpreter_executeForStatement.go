@@ -0,0 +1,250 @@
+package interpreter
+
+import (
+	"fmt"
+	"zen/lang/parsing/ast"
+	"zen/lang/parsing/expression"
+	"zen/lang/parsing/statement"
+	"zen/runtime/types"
+)
+
+// executeForStatement handles C-style for loops, e.g. for i = 0; i < 10; i++ { ... }
+// An assignment in the initialization implicitly declares the loop variable in the loop's own scope
+func (i *Interpreter) executeForStatement(stmt *statement.ForStatement) error {
+	i.env.BeginScope()
+	defer i.env.EndScope()
+
+	if err := i.executeForInit(stmt.Init); err != nil {
+		return err
+	}
+
+	wasInLoop := i.inLoop
+	i.inLoop = true
+	defer func() { i.inLoop = wasInLoop }()
+
+	for {
+		if stmt.Condition != nil {
+			condition, err := i.EvaluateExpression(stmt.Condition)
+			if err != nil {
+				return err
+			}
+			if condition.Type() != types.TypeBool {
+				return &RuntimeError{
+					Message:  fmt.Sprintf("For condition must be a boolean, got %s", condition.Type()),
+					Location: stmt.Condition.GetLocation(),
+				}
+			}
+			if !condition.IsTruthy() {
+				return nil
+			}
+		}
+
+		stop, err := i.executeLoopBody(stmt.Body, nil)
+		if err != nil || stop {
+			return err
+		}
+
+		if stmt.Increment != nil {
+			if err := i.ExecuteStatement(stmt.Increment); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// executeForInit executes the initialization of a C-style for loop
+func (i *Interpreter) executeForInit(init ast.Statement) error {
+	if init == nil {
+		return nil
+	}
+
+	if exprStmt, ok := init.(*statement.ExpressionStatement); ok {
+		if assign, ok := exprStmt.Expression.(*expression.BinaryExpression); ok && assign.Operator == "=" {
+			if id, ok := assign.Left.(*expression.IdentifierExpression); ok {
+				value, err := i.EvaluateExpression(assign.Right)
+				if err != nil {
+					return err
+				}
+				return i.defineLoopVariable(id.Name, value, init)
+			}
+		}
+	}
+
+	return i.ExecuteStatement(init)
+}
+
+// executeForInStatement handles for-in loops over arrays, maps, ranges and integers, e.g. for index, name in names { ... }
+// With a single variable it holds each value, with two variables the first holds the index (or key for maps)
+func (i *Interpreter) executeForInStatement(stmt *statement.ForInStatement) error {
+	container, err := i.EvaluateExpression(stmt.Container)
+	if err != nil {
+		return err
+	}
+
+	iterator, err := i.iteratorOf(container)
+	if err != nil {
+		return &RuntimeError{
+			Message:  err.Error(),
+			Location: stmt.Container.GetLocation(),
+		}
+	}
+
+	// loop.length and loop.last are known up front for collections and ranges, but not for views or under a where-clause
+	length := int64(-1)
+	if sized, ok := iterator.(types.SizedIterator); ok && stmt.Condition == nil {
+		length = sized.Len()
+	}
+
+	wasInLoop := i.inLoop
+	i.inLoop = true
+	defer func() { i.inLoop = wasInLoop }()
+
+	index := int64(0)
+	for key, value, ok := iterator.Next(); ok; key, value, ok = iterator.Next() {
+		// The where-clause is evaluated for each element once the body has run for the previous one
+		if stmt.Condition != nil {
+			matches, err := i.matchesCondition(stmt.Condition, func() error {
+				return i.defineIterationVariables(stmt, key, value)
+			})
+			if err != nil {
+				return err
+			}
+			if !matches {
+				continue
+			}
+		}
+
+		loop := types.NewLoopInfo(index, length)
+		stop, err := i.executeLoopBody(stmt.Body, func() error {
+			if err := i.defineLoopObject(loop, stmt); err != nil {
+				return err
+			}
+			return i.defineIterationVariables(stmt, key, value)
+		})
+		if err != nil || stop {
+			return err
+		}
+		index++
+	}
+
+	if fallible, ok := iterator.(types.FallibleIterator); ok {
+		return fallible.Err()
+	}
+	return nil
+}
+
+// defineIterationVariables defines the key and value variables of a for-in iteration in the current scope
+func (i *Interpreter) defineIterationVariables(stmt *statement.ForInStatement, key, value types.Value) error {
+	if stmt.Key != "" {
+		if err := i.defineLoopVariable(stmt.Key, key, stmt); err != nil {
+			return err
+		}
+	}
+	return i.defineLoopVariable(stmt.Value, value, stmt)
+}
+
+// defineLoopObject defines the read-only 'loop' object of a for-in iteration, shadowing that of any enclosing loop
+// Loop variables named 'loop' take precedence over it
+func (i *Interpreter) defineLoopObject(loop *types.LoopInfo, stmt *statement.ForInStatement) error {
+	if stmt.Key == "loop" || stmt.Value == "loop" {
+		return nil
+	}
+	if err := i.env.DefineConst("loop", loop); err != nil {
+		return &RuntimeError{
+			Message:  err.Error(),
+			Location: stmt.GetLocation(),
+		}
+	}
+	return nil
+}
+
+// iteratorOf returns an iterator over the elements of a for-in container.
+// Objects of classes extending Array or Map iterate over the value they wrap
+func (i *Interpreter) iteratorOf(container types.Value) (types.Iterator, error) {
+	switch c := types.Unwrap(container).(type) {
+	case types.Iterable:
+		return c.Iterator(), nil
+	case *types.Int:
+		return countTo(int64(c.Value())), nil
+	case *types.Int64:
+		return countTo(c.Value()), nil
+	default:
+		return nil, fmt.Errorf("Cannot iterate over %s", container.Type())
+	}
+}
+
+// countTo returns an iterator counting from 0 up to, but not including, n
+// Negative counts don't iterate at all
+func countTo(n int64) types.Iterator {
+	if n < 0 {
+		n = 0
+	}
+	return types.NewRange(0, n).Iterator()
+}
+
+// executeLoopBody runs one iteration of a loop body in a new scope.
+// The optional setup function defines the iteration's variables in that scope.
+// Returns true if the loop should stop because of a break statement
+func (i *Interpreter) executeLoopBody(body []ast.Statement, setup func() error) (bool, error) {
+	i.env.BeginScope()
+	defer i.env.EndScope()
+
+	if setup != nil {
+		if err := setup(); err != nil {
+			return false, err
+		}
+	}
+
+	for _, stmt := range body {
+		if err := i.ExecuteStatement(stmt); err != nil {
+			switch err.(type) {
+			case *breakSignal:
+				return true, nil
+			case *continueSignal:
+				return false, nil
+			}
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// defineLoopVariable defines a loop variable in the current scope
+func (i *Interpreter) defineLoopVariable(name string, value types.Value, stmt ast.Statement) error {
+	var err error
+	if value.Type() == types.TypeNull {
+		err = i.env.DefineNullable(name, nil)
+	} else {
+		err = i.env.Define(name, types.ToGoValue(value))
+	}
+
+	if err != nil {
+		return &RuntimeError{
+			Message:  err.Error(),
+			Location: stmt.GetLocation(),
+		}
+	}
+	return nil
+}
+
+// executeBreakStatement stops the innermost loop
+func (i *Interpreter) executeBreakStatement(stmt *statement.BreakStatement) error {
+	if !i.inLoop {
+		return &RuntimeError{
+			Message:  "Cannot use 'break' outside of a loop",
+			Location: stmt.GetLocation(),
+		}
+	}
+	return &breakSignal{Location: stmt.GetLocation()}
+}
+
+// executeContinueStatement skips to the next iteration of the innermost loop
+func (i *Interpreter) executeContinueStatement(stmt *statement.ContinueStatement) error {
+	if !i.inLoop {
+		return &RuntimeError{
+			Message:  "Cannot use 'continue' outside of a loop",
+			Location: stmt.GetLocation(),
+		}
+	}
+	return &continueSignal{Location: stmt.GetLocation()}
+}
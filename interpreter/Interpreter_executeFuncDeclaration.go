@@ -0,0 +1,65 @@
+package interpreter
+
+import (
+	"zen/lang/parsing/expression"
+	"zen/lang/parsing/statement"
+	"zen/runtime/types"
+)
+
+// executeFuncDeclaration binds a function declaration to its name in the current scope
+// The function captures the current scope, so nested functions can access the enclosing function's variables,
+// as well as the trait members renamed for the enclosing function and the members of the enclosing method's class.
+// Private top-level functions can't be imported by other modules.
+// Generic functions capture a scope defining their type parameters instead, see declareTypeParameters
+func (i *Interpreter) executeFuncDeclaration(stmt *statement.FuncDeclaration) error {
+	fn := types.NewUserFunction(stmt.Name, stmt.Parameters, stmt.ReturnType, stmt.Body, stmt.Async, i.env.CurrentScope())
+	fn.Aliases = i.memberAliases
+	fn.Enclosing = i.currentClass
+	if len(stmt.TypeParameters) > 0 {
+		params, closure, err := i.declareTypeParameters(stmt.Name, stmt.TypeParameters)
+		if err != nil {
+			return err
+		}
+		fn.TypeParameters, fn.Closure = params, closure
+	}
+
+	if err := i.env.DefineConst(stmt.Name, fn); err != nil {
+		return &RuntimeError{
+			Message:  err.Error(),
+			Location: stmt.GetLocation(),
+		}
+	}
+	return i.declareVisibility(stmt.Name, stmt.Visibility, stmt.GetLocation())
+}
+
+// executeReturnStatement evaluates the optional return value and unwinds to the enclosing function call
+func (i *Interpreter) executeReturnStatement(stmt *statement.ReturnStatmenet) error {
+	if !i.inFunction && !i.inInclude {
+		return &RuntimeError{
+			Message:  "Cannot return outside of a function",
+			Location: stmt.GetLocation(),
+		}
+	}
+
+	var value types.Value
+	if stmt.Expression != nil {
+		val, err := i.EvaluateExpression(stmt.Expression)
+		if err != nil {
+			return err
+		}
+		value = val
+	}
+
+	return &returnSignal{
+		Value:    value,
+		Location: stmt.GetLocation(),
+	}
+}
+
+// evaluateLambda creates a closure over the current scope
+func (i *Interpreter) evaluateLambda(expr *expression.LambdaExpression) (types.Value, error) {
+	fn := types.NewLambda(expr.Parameters, expr.Body, i.env.CurrentScope())
+	fn.Aliases = i.memberAliases
+	fn.Enclosing = i.currentClass
+	return fn, nil
+}
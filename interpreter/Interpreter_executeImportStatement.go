@@ -0,0 +1,130 @@
+package interpreter
+
+import (
+	"fmt"
+	"zen/lang/common"
+	"zen/lang/parsing/statement"
+	"zen/runtime/types"
+)
+
+// executeImportStatement defines the symbols of the modules an import refers to in the current scope, see resolveImport.
+//   - import GameEngine/Core defines the symbols of every module in the namespace directly
+//   - import GameEngine/Core as gec defines them as members of the namespace gec
+//   - import GameEngine/Core/Vector2 as CoreVector2 defines the only symbol of a single-symbol module as CoreVector2
+//   - from GameEngine/Core/Utils import hello defines the selected symbols only
+//
+// Imported symbols are constants
+func (i *Interpreter) executeImportStatement(stmt *statement.ImportStatement) error {
+	modules, isNamespace, err := i.resolveImport(stmt)
+	if err != nil {
+		return err
+	}
+
+	if stmt.Symbols != nil {
+		return i.importSymbols(stmt, modules)
+	}
+
+	if stmt.Alias == "" {
+		for _, module := range modules {
+			for _, symbol := range module.Symbols() {
+				if err := i.importSymbol(module, symbol, symbol, stmt.GetLocation()); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	if !isNamespace {
+		if symbols := modules[0].Symbols(); len(symbols) == 1 {
+			return i.importSymbol(modules[0], symbols[0], stmt.Alias, stmt.GetLocation())
+		}
+	}
+
+	namespace := types.NewNamespace(stmt.PathString())
+	for _, module := range modules {
+		for _, symbol := range module.Symbols() {
+			info, _ := module.Scope.GetLocal(symbol)
+			value, err := types.FromGoValue(info.Value())
+			if err != nil {
+				return &RuntimeError{
+					Message:  err.Error(),
+					Location: stmt.GetLocation(),
+				}
+			}
+			namespace.Define(symbol, value)
+		}
+	}
+	return i.defineImport(stmt.Alias, namespace, nil, stmt.GetLocation())
+}
+
+// importSymbols defines the symbols selected by a from import, looking each of them up in the modules it refers to
+func (i *Interpreter) importSymbols(stmt *statement.ImportStatement, modules []*Module) error {
+	for _, symbol := range stmt.Symbols {
+		module := findSymbol(modules, symbol.Name)
+		if module == nil {
+			return &RuntimeError{
+				Message:  fmt.Sprintf("'%s' has no symbol '%s'", stmt.PathString(), symbol.Name),
+				Location: symbol.Location,
+			}
+		}
+
+		if info, _ := module.Scope.GetLocal(symbol.Name); info.IsPrivate() {
+			return &RuntimeError{
+				Message:  fmt.Sprintf("Cannot import '%s', it is private to module '%s'", symbol.Name, module.Name),
+				Location: symbol.Location,
+			}
+		}
+
+		name := symbol.Name
+		if symbol.Alias != "" {
+			name = symbol.Alias
+		}
+		if err := i.importSymbol(module, symbol.Name, name, symbol.Location); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findSymbol returns the module declaring a top-level symbol with the given name, or nil if none of them do
+func findSymbol(modules []*Module, name string) *Module {
+	for _, module := range modules {
+		if _, ok := module.Scope.GetLocal(name); ok {
+			return module
+		}
+	}
+	return nil
+}
+
+// importSymbol defines a top-level symbol of a module in the current scope under the given name, with its declared type
+func (i *Interpreter) importSymbol(module *Module, symbol string, name string, location *common.SourceLocation) error {
+	info, _ := module.Scope.GetLocal(symbol)
+	return i.defineImport(name, info.Value(), info.TypeHint(), location)
+}
+
+// defineImport defines an imported value as a constant, or as a nullable variable for null values.
+// Importing the same value under the same name again, e.g. a class imported both with its namespace and on its own, has no effect
+func (i *Interpreter) defineImport(name string, value interface{}, hint interface{}, location *common.SourceLocation) error {
+	if existing, ok := i.env.CurrentScope().GetLocal(name); ok && existing.Value() == value && value != nil {
+		return nil
+	}
+
+	var err error
+	if value == nil {
+		err = i.env.DefineNullable(name, value)
+	} else {
+		err = i.env.DefineConst(name, value)
+	}
+	if err == nil && hint != nil {
+		err = i.env.SetTypeHint(name, hint)
+	}
+
+	if err != nil {
+		return &RuntimeError{
+			Message:  err.Error(),
+			Location: location,
+		}
+	}
+	return nil
+}
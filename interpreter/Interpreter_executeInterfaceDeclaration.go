@@ -0,0 +1,167 @@
+package interpreter
+
+import (
+	"fmt"
+	"zen/lang/common"
+	"zen/lang/parsing/statement"
+	"zen/runtime/types"
+)
+
+// executeInterfaceDeclaration creates an interface and binds it to its name in the current scope
+// The interface is defined before its members are resolved, so they can refer to the interface itself.
+// The members of generic interfaces are declared in a scope defining their type parameters, see declareTypeParameters,
+// and resolved again with the type arguments the interface is given, see instantiateInterface
+func (i *Interpreter) executeInterfaceDeclaration(stmt *statement.InterfaceDeclaration) error {
+	iface := types.NewInterface(stmt.Name)
+
+	if err := i.env.DefineConst(stmt.Name, iface); err != nil {
+		return &RuntimeError{
+			Message:  err.Error(),
+			Location: stmt.GetLocation(),
+		}
+	}
+
+	if len(stmt.TypeParameters) > 0 {
+		params, closure, err := i.declareTypeParameters(stmt.Name, stmt.TypeParameters)
+		if err != nil {
+			return err
+		}
+		iface.TypeParameters, iface.Closure = params, closure
+
+		previous := i.env.BeginScopeFrom(closure)
+		defer i.env.RestoreScope(previous)
+	}
+
+	for _, property := range stmt.Properties {
+		if iface.HasMember(property.Name) {
+			return &RuntimeError{
+				Message:  fmt.Sprintf("Member '%s' is already declared in interface '%s'", property.Name, stmt.Name),
+				Location: property.GetLocation(),
+			}
+		}
+
+		hint, err := i.resolveTypeHint(property.Type, property.IsNullable)
+		if err != nil {
+			return err
+		}
+		iface.Properties = append(iface.Properties, &types.InterfaceProperty{
+			Name:           property.Name,
+			Type:           hint,
+			TypeExpression: property.Type,
+			Constant:       property.IsConstant,
+			Visibility:     property.Visibility,
+		})
+	}
+
+	for _, method := range stmt.Methods {
+		if iface.HasMember(method.Name) {
+			return &RuntimeError{
+				Message:  fmt.Sprintf("Member '%s' is already declared in interface '%s'", method.Name, stmt.Name),
+				Location: method.GetLocation(),
+			}
+		}
+
+		signature, err := i.resolveFunctionType(method.Parameters, method.ReturnType)
+		if err != nil {
+			return err
+		}
+		iface.Methods = append(iface.Methods, &types.InterfaceMethod{
+			Name:       method.Name,
+			Signature:  signature,
+			Parameters: method.Parameters,
+			ReturnType: method.ReturnType,
+			Visibility: method.Visibility,
+		})
+	}
+
+	return i.declareVisibility(stmt.Name, stmt.Visibility, stmt.GetLocation())
+}
+
+// instantiateInterface returns a generic interface given type arguments, e.g. BracketGet<int, int>,
+// whose members are resolved with the type parameters bound to them, see bindTypeArguments
+func (i *Interpreter) instantiateInterface(iface *types.Interface, args []types.Value, location *common.SourceLocation) (*types.Interface, error) {
+	if err := i.checkTypeArguments(iface.Name, iface.TypeParameters, args, location); err != nil {
+		return nil, err
+	}
+
+	previous := i.env.BeginScopeFrom(iface.Closure)
+	defer i.env.RestoreScope(previous)
+	if err := i.bindTypeArguments(iface.TypeParameters, args); err != nil {
+		return nil, &RuntimeError{
+			Message:  err.Error(),
+			Location: location,
+		}
+	}
+
+	instance := types.NewInterface(iface.Name)
+	instance.Generic, instance.TypeArguments = iface, args
+	for _, property := range iface.Properties {
+		hint, err := i.resolveTypeHint(property.TypeExpression, property.Type.Nullable)
+		if err != nil {
+			return nil, err
+		}
+		bound := *property
+		bound.Type = hint
+		instance.Properties = append(instance.Properties, &bound)
+	}
+	for _, method := range iface.Methods {
+		signature, err := i.resolveFunctionType(method.Parameters, method.ReturnType)
+		if err != nil {
+			return nil, err
+		}
+		bound := *method
+		bound.Signature = signature
+		instance.Methods = append(instance.Methods, &bound)
+	}
+	return instance, nil
+}
+
+// interfaceMismatch checks whether a class has the members of an interface, with compatible types.
+// Properties must be fields of the same type, which can't be constant unless the property is,
+// and methods must have compatible signatures, see compatibleSignature. Members can't be less visible than required.
+// If member is not empty, only that member of the interface is checked.
+// Returns a description of the first member that doesn't match, or "" if the class has all of them
+func (i *Interpreter) interfaceMismatch(class *types.Class, iface *types.Interface, member string) (string, error) {
+	for _, property := range iface.Properties {
+		if member != "" && property.Name != member {
+			continue
+		}
+
+		field := class.GetField(property.Name)
+		if field == nil {
+			return fmt.Sprintf("missing property '%s'", property.Name), nil
+		}
+		if field.Type.String() != property.Type.String() {
+			return fmt.Sprintf("property '%s' must be of type %s, got %s", property.Name, property.Type, field.Type), nil
+		}
+		if field.Constant && !property.Constant {
+			return fmt.Sprintf("property '%s' must not be constant", property.Name), nil
+		}
+		if field.Visibility.IsNarrowerThan(property.Visibility) {
+			return fmt.Sprintf("property '%s' must be %s, got %s", property.Name, property.Visibility, field.Visibility), nil
+		}
+	}
+
+	for _, method := range iface.Methods {
+		if member != "" && method.Name != member {
+			continue
+		}
+
+		fn := class.FindMethod(method.Name)
+		if fn == nil {
+			return fmt.Sprintf("missing method '%s'", method.Name), nil
+		}
+		signature, err := i.resolveSignature(fn)
+		if err != nil {
+			return "", err
+		}
+		if !compatibleSignature(signature, method.Signature) {
+			return fmt.Sprintf("method '%s' must have signature %s, got %s", method.Name, method.Signature, signature), nil
+		}
+		if fn.Visibility.IsNarrowerThan(method.Visibility) {
+			return fmt.Sprintf("method '%s' must be %s, got %s", method.Name, method.Visibility, fn.Visibility), nil
+		}
+	}
+
+	return "", nil
+}
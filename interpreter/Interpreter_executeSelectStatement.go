@@ -0,0 +1,68 @@
+package interpreter
+
+import (
+	"fmt"
+	"zen/lang/parsing/statement"
+	"zen/runtime/async"
+	"zen/runtime/types"
+)
+
+// executeSelectStatement performs the send or receive of the first arm that can proceed and executes its body,
+// in a new scope in which the received value is defined if the arm names it, null once the channel is closed.
+// Without an else arm, the isolate blocks until an arm can proceed, otherwise the else body is executed if none can right away.
+// The channels and values to send of all arms are evaluated first, in order
+func (i *Interpreter) executeSelectStatement(stmt *statement.SelectStatement) error {
+	cases := make([]async.SelectCase, len(stmt.Arms))
+	for idx, arm := range stmt.Arms {
+		value, err := i.EvaluateExpression(arm.Channel)
+		if err != nil {
+			return err
+		}
+		channel, ok := value.(*async.Channel)
+		if !ok {
+			return &RuntimeError{
+				Message:  fmt.Sprintf("Cannot select on %s, expected a Channel", value.Type()),
+				Location: arm.Location,
+			}
+		}
+		cases[idx].Channel = channel
+
+		if arm.Send {
+			if cases[idx].Value, err = i.EvaluateExpression(arm.Value); err != nil {
+				return err
+			}
+			cases[idx].Send = true
+		}
+	}
+
+	index, received, _, err := async.Select(cases, !stmt.HasElse)
+	if err != nil {
+		return &RuntimeError{
+			Message:  err.Error(),
+			Location: stmt.GetLocation(),
+		}
+	}
+	if index < 0 {
+		return i.executeBlock(stmt.Else)
+	}
+
+	arm := stmt.Arms[index]
+	if arm.Alias == "" {
+		return i.executeBlock(arm.Body)
+	}
+
+	i.env.BeginScope()
+	defer i.env.EndScope()
+	if err := i.env.DefineNullable(arm.Alias, types.ToGoValue(received)); err != nil {
+		return &RuntimeError{
+			Message:  err.Error(),
+			Location: arm.Location,
+		}
+	}
+	for _, stmt := range arm.Body {
+		if err := i.ExecuteStatement(stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
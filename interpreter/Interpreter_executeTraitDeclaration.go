@@ -0,0 +1,182 @@
+package interpreter
+
+import (
+	"fmt"
+	"zen/lang/parsing/statement"
+	"zen/runtime/types"
+)
+
+// executeTraitDeclaration creates a trait and binds it to its name in the current scope
+// Fields and methods are resolved like those of a class, but only become members of the classes using the trait
+func (i *Interpreter) executeTraitDeclaration(stmt *statement.TraitDeclaration) error {
+	trait := types.NewTrait(stmt.Name, i.env.CurrentScope())
+
+	if err := i.env.DefineConst(stmt.Name, trait); err != nil {
+		return &RuntimeError{
+			Message:  err.Error(),
+			Location: stmt.GetLocation(),
+		}
+	}
+
+	for _, field := range stmt.Fields {
+		if trait.HasMember(field.Name) {
+			return &RuntimeError{
+				Message:  fmt.Sprintf("Member '%s' is already declared in trait '%s'", field.Name, stmt.Name),
+				Location: field.GetLocation(),
+			}
+		}
+
+		hint, err := i.resolveTypeHint(field.Type, field.IsNullable)
+		if err != nil {
+			return err
+		}
+		trait.Fields = append(trait.Fields, &types.ClassField{
+			Name:       field.Name,
+			Type:       hint,
+			Default:    field.Initializer,
+			Constant:   field.IsConstant,
+			Visibility: field.Visibility,
+			Closure:    trait.Closure,
+			Location:   field.GetLocation(),
+		})
+	}
+
+	for _, method := range stmt.Methods {
+		fn := types.NewUserFunction(method.Name, method.Parameters, method.ReturnType, method.Body, method.Async, i.env.CurrentScope())
+		fn.Visibility = method.Visibility
+
+		if method.Name == "init" {
+			if trait.Init != nil {
+				return &RuntimeError{
+					Message:  fmt.Sprintf("Trait '%s' can only have one init", stmt.Name),
+					Location: method.GetLocation(),
+				}
+			}
+			trait.Init = fn
+			continue
+		}
+
+		if trait.HasMember(method.Name) {
+			return &RuntimeError{
+				Message:  fmt.Sprintf("Member '%s' is already declared in trait '%s'", method.Name, stmt.Name),
+				Location: method.GetLocation(),
+			}
+		}
+		trait.Methods = append(trait.Methods, fn)
+	}
+
+	return i.declareVisibility(stmt.Name, stmt.Visibility, stmt.GetLocation())
+}
+
+// useTrait adds the fields and methods of a trait to a class, renamed as listed in the use's aliases.
+// A member may not clash with a field of the class, nor with a member provided by another trait,
+// which provided maps to the name of the trait providing it. Methods declared by the class itself take precedence
+// over methods of the trait, and methods of the trait override inherited methods
+func (i *Interpreter) useTrait(class *types.Class, use *statement.TraitUse, provided map[string]string) error {
+	value, err := i.EvaluateExpression(use.Trait)
+	if err != nil {
+		return err
+	}
+	trait, ok := value.(*types.Trait)
+	if !ok {
+		return &RuntimeError{
+			Message:  fmt.Sprintf("Class '%s' cannot use %s, expected a trait", class.Name, value.Type()),
+			Location: use.Trait.GetLocation(),
+		}
+	}
+
+	aliases := make(map[string]string, len(use.Aliases))
+	for _, alias := range use.Aliases {
+		if !trait.HasMember(alias.Member) {
+			return &RuntimeError{
+				Message:  fmt.Sprintf("Trait '%s' has no member '%s'", trait.Name, alias.Member),
+				Location: alias.Location,
+			}
+		}
+		aliases[alias.Member] = alias.Alias
+	}
+	nameOf := func(member string) string {
+		if alias, ok := aliases[member]; ok {
+			return alias
+		}
+		return member
+	}
+
+	for _, field := range trait.Fields {
+		name := nameOf(field.Name)
+		if err := checkTraitMember(class, trait, name, provided); err != nil {
+			return &RuntimeError{Message: err.Error(), Location: use.Location}
+		}
+		if class.HasMember(name) {
+			return &RuntimeError{
+				Message:  fmt.Sprintf("Field '%s' of trait '%s' conflicts with member '%s' of class '%s'", field.Name, trait.Name, name, class.Name),
+				Location: use.Location,
+			}
+		}
+
+		renamed := *field
+		renamed.Name = name
+		renamed.Owner = class
+		class.Fields = append(class.Fields, &renamed)
+		provided[name] = trait.Name
+	}
+
+	for _, method := range trait.Methods {
+		name := nameOf(method.Name)
+		if err := checkTraitMember(class, trait, name, provided); err != nil {
+			return &RuntimeError{Message: err.Error(), Location: use.Location}
+		}
+		if class.GetField(name) != nil {
+			return &RuntimeError{
+				Message:  fmt.Sprintf("Method '%s' of trait '%s' conflicts with field '%s' of class '%s'", method.Name, trait.Name, name, class.Name),
+				Location: use.Location,
+			}
+		}
+		if _, declared := class.Methods[name]; declared {
+			continue
+		}
+
+		fn := bindTraitFunction(method, class, class.Name+"."+name, aliases)
+		if class.Superclass != nil {
+			if overridden := class.Superclass.FindMethod(name); overridden != nil {
+				if err := i.checkOverride(fn, overridden, use.Location); err != nil {
+					return err
+				}
+			}
+		}
+		class.Methods[name] = fn
+		provided[name] = trait.Name
+	}
+
+	if trait.Init == nil && len(use.Arguments) > 0 {
+		return &RuntimeError{
+			Message:  fmt.Sprintf("Trait '%s' has no init to pass arguments to", trait.Name),
+			Location: use.Location,
+		}
+	}
+
+	traitUse := &types.TraitUse{Trait: trait, Arguments: use.Arguments}
+	if trait.Init != nil {
+		traitUse.Init = bindTraitFunction(trait.Init, class, class.Name+"."+trait.Name+".init", aliases)
+	}
+	class.Traits = append(class.Traits, traitUse)
+	return nil
+}
+
+// checkTraitMember returns an error if another trait used by the class already provides a member with the given name
+func checkTraitMember(class *types.Class, trait *types.Trait, name string, provided map[string]string) error {
+	if other, ok := provided[name]; ok {
+		return fmt.Errorf("Member '%s' of class '%s' is provided by both trait '%s' and trait '%s', rename one with 'as'",
+			name, class.Name, other, trait.Name)
+	}
+	return nil
+}
+
+// bindTraitFunction copies a method of a trait into the class using it
+func bindTraitFunction(method *types.UserFunction, class *types.Class, name string, aliases map[string]string) *types.UserFunction {
+	fn := *method
+	fn.Name = name
+	fn.Owner = class
+	fn.Aliases = aliases
+	return &fn
+}
@@ -0,0 +1,160 @@
+package interpreter
+
+import (
+	"fmt"
+	"strings"
+	"zen/lang/common"
+	"zen/lang/parsing/ast"
+	"zen/lang/parsing/statement"
+	"zen/runtime/errors"
+	"zen/runtime/types"
+)
+
+// executeThrowStatement throws an object of a class extending Exception, e.g. throw new NotFoundException("no such user")
+func (i *Interpreter) executeThrowStatement(stmt *statement.ThrowStatement) error {
+	value, err := i.EvaluateExpression(stmt.Expression)
+	if err != nil {
+		return err
+	}
+
+	object, ok := value.(*types.Object)
+	if !ok || !object.Class.IsSubclassOf(i.exceptionClasses[errors.ExceptionClass]) {
+		return &RuntimeError{
+			Message:   fmt.Sprintf("Cannot throw %s, expected an Exception", types.Inspect(value)),
+			Location:  stmt.Expression.GetLocation(),
+			Exception: errors.TypeExceptionClass,
+		}
+	}
+	return i.newException(object, stmt.GetLocation())
+}
+
+// newException creates an exception thrown at the given location from within the active function calls
+func (i *Interpreter) newException(object *types.Object, location *common.SourceLocation) *errors.Exception {
+	return errors.NewException(object, location, i.stackTrace(location))
+}
+
+// executeTryStatement executes the body of a try statement, handing an exception thrown in it to the first catch clause
+// whose type matches. Runtime errors raised by the interpreter are caught as the built-in exception classes, see toException.
+// The finally block runs however the body and catch clause exit, including return, break and continue.
+// An exception thrown or a return from within the finally block replaces the way the try statement was exiting
+func (i *Interpreter) executeTryStatement(stmt *statement.TryStatement) error {
+	err := i.executeBlock(stmt.Body)
+
+	if exception := i.toException(err); exception != nil {
+		catch, matchErr := i.findCatchClause(stmt, exception)
+		if matchErr != nil {
+			err = matchErr
+		} else if catch != nil {
+			err = i.executeCatchClause(catch, exception)
+		}
+	}
+
+	if stmt.Finally != nil {
+		if finallyErr := i.executeBlock(stmt.Finally); finallyErr != nil {
+			return finallyErr
+		}
+	}
+	return err
+}
+
+// toException returns the exception an error is thrown as, or nil for errors that aren't thrown, such as a return.
+// Runtime errors are converted to an object of the built-in exception class they are raised as, see RuntimeError.Exception
+func (i *Interpreter) toException(err error) *errors.Exception {
+	switch e := err.(type) {
+	case *errors.Exception:
+		return e
+	case *RuntimeError:
+		name := e.Exception
+		if name == "" {
+			name = errors.RuntimeExceptionClass
+		}
+		object := types.NewObject(i.exceptionClasses[name])
+		if err := object.InitField(object.Class.GetField("message"), types.NewString(exceptionMessage(e.Message))); err != nil {
+			return nil
+		}
+		if e.Stack != nil {
+			return errors.NewException(object, e.Location, e.Stack)
+		}
+		return i.newException(object, e.Location)
+	}
+	return nil
+}
+
+// exceptionMessage returns the message of a runtime error without the prefix of the Go error it was built from,
+// e.g. division by zero rather than Type error: division by zero
+func exceptionMessage(message string) string {
+	for _, prefix := range []string{"Type error: ", "Index error: ", "Runtime error: "} {
+		message = strings.TrimPrefix(message, prefix)
+	}
+	return message
+}
+
+// findCatchClause returns the first catch clause of a try statement catching the exception, or nil if none does.
+// Catch clauses without a type catch any exception
+func (i *Interpreter) findCatchClause(stmt *statement.TryStatement, exception *errors.Exception) (*statement.CatchClause, error) {
+	for _, catch := range stmt.Catches {
+		if catch.Type == nil {
+			return catch, nil
+		}
+
+		hint, err := i.resolveTypeHint(catch.Type, false)
+		if err != nil {
+			return nil, err
+		}
+		if !i.isExceptionType(hint) {
+			return nil, &RuntimeError{
+				Message:  fmt.Sprintf("Cannot catch %s, expected a class extending Exception", hint),
+				Location: catch.Type.GetLocation(),
+			}
+		}
+		if hint.Matches(exception.Object) {
+			return catch, nil
+		}
+	}
+	return nil, nil
+}
+
+// isExceptionType returns true for classes extending Exception, and unions of them
+func (i *Interpreter) isExceptionType(hint *types.TypeHint) bool {
+	if hint.IsUnion() {
+		for _, member := range hint.Arguments {
+			if !i.isExceptionType(member) {
+				return false
+			}
+		}
+		return true
+	}
+	return hint.Class != nil && hint.Class.IsSubclassOf(i.exceptionClasses[errors.ExceptionClass])
+}
+
+// executeCatchClause executes the body of a catch clause in a new scope, in which the caught exception is a constant
+func (i *Interpreter) executeCatchClause(catch *statement.CatchClause, exception *errors.Exception) error {
+	i.env.BeginScope()
+	defer i.env.EndScope()
+
+	if err := i.env.DefineConst(catch.Name, exception.Object); err != nil {
+		return &RuntimeError{
+			Message:  err.Error(),
+			Location: catch.Location,
+		}
+	}
+	for _, stmt := range catch.Body {
+		if err := i.ExecuteStatement(stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// executeBlock executes a block of statements in a new scope
+func (i *Interpreter) executeBlock(body []ast.Statement) error {
+	i.env.BeginScope()
+	defer i.env.EndScope()
+
+	for _, stmt := range body {
+		if err := i.ExecuteStatement(stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package interpreter
+
+import (
+	"fmt"
+	"zen/lang/parsing/ast"
+	"zen/lang/parsing/expression"
+	"zen/lang/parsing/statement"
+	"zen/runtime/types"
+)
+
+// executeWhenStatement executes the body of the first arm matching the subject, or the else arm if none does
+func (i *Interpreter) executeWhenStatement(stmt *statement.WhenStatement) error {
+	subject, err := i.EvaluateExpression(stmt.Subject)
+	if err != nil {
+		return err
+	}
+
+	for _, arm := range stmt.Arms {
+		matched, err := i.matchWhenArm(arm, subject)
+		if err != nil {
+			return err
+		}
+		if matched {
+			return i.executeWhenBody(arm.Body)
+		}
+	}
+
+	if stmt.HasElse {
+		return i.executeWhenBody(stmt.Else)
+	}
+	return i.checkWhenExhaustive(stmt, subject)
+}
+
+// executeWhenBody executes the body of a when arm in a new scope
+func (i *Interpreter) executeWhenBody(body []ast.Statement) error {
+	i.env.BeginScope()
+	defer i.env.EndScope()
+
+	for _, stmt := range body {
+		if err := i.ExecuteStatement(stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// matchWhenArm returns true if any pattern of the arm matches the subject
+func (i *Interpreter) matchWhenArm(arm *statement.WhenArm, subject types.Value) (bool, error) {
+	for _, pattern := range arm.Patterns {
+		matched, err := i.matchWhenPattern(pattern, subject)
+		if err != nil || matched {
+			return matched, err
+		}
+	}
+	return false, nil
+}
+
+// matchWhenPattern returns true if a single pattern matches the subject:
+// type patterns match values of the type, predicates match if they evaluate to true,
+// ranges match their elements, like for ... in, see types.Range.Contains, and other values match equal subjects
+func (i *Interpreter) matchWhenPattern(pattern *statement.WhenPattern, subject types.Value) (bool, error) {
+	switch pattern.Kind {
+	case statement.WhenType:
+		hint, err := i.resolveTypeHint(pattern.Type, false)
+		if err != nil {
+			return false, err
+		}
+		return hint.Matches(subject), nil
+
+	case statement.WhenPredicate:
+		i.whenSubjects = append(i.whenSubjects, subject)
+		result, err := i.EvaluateExpression(pattern.Value)
+		i.whenSubjects = i.whenSubjects[:len(i.whenSubjects)-1]
+		if err != nil {
+			return false, err
+		}
+		if result.Type() != types.TypeBool {
+			return false, &RuntimeError{
+				Message:  fmt.Sprintf("When predicate must be a boolean, got %s", result.Type()),
+				Location: pattern.Location,
+			}
+		}
+		return result.IsTruthy(), nil
+	}
+
+	value, err := i.EvaluateExpression(pattern.Value)
+	if err != nil {
+		return false, err
+	}
+
+	if r, ok := value.(*types.Range); ok {
+		switch s := subject.(type) {
+		case *types.Int:
+			return r.Contains(int64(s.Value())), nil
+		case *types.Int64:
+			return r.Contains(s.Value()), nil
+		}
+		return false, nil
+	}
+
+	// Values of incompatible types are never equal, but errors raised by an _equals method are reported
+	result, err := types.BinaryOp(subject, value, "==", i.methodCaller(pattern.Location))
+	if err != nil {
+		if _, ok := err.(*types.TypeError); ok {
+			return false, nil
+		}
+		return false, err
+	}
+	return result.IsTruthy(), nil
+}
+
+// evaluateWhenSubject returns the subject of the innermost when statement, referred to by a leading-dot predicate
+func (i *Interpreter) evaluateWhenSubject(expr *expression.WhenSubjectExpression) (types.Value, error) {
+	if len(i.whenSubjects) == 0 {
+		return nil, &RuntimeError{
+			Message:  "Cannot use a leading '.' outside of a when pattern",
+			Location: expr.GetLocation(),
+		}
+	}
+	return i.whenSubjects[len(i.whenSubjects)-1], nil
+}
+
+// checkWhenExhaustive warns about a when statement without an else arm whose subject, a variable of a union type,
+// is a value no arm matches, unless every member of the union is matched by a type pattern.
+// Each statement is only reported once
+func (i *Interpreter) checkWhenExhaustive(stmt *statement.WhenStatement, subject types.Value) error {
+	if i.warnedWhens[stmt] {
+		return nil
+	}
+
+	identifier, ok := stmt.Subject.(*expression.IdentifierExpression)
+	if !ok {
+		return nil
+	}
+	hint, ok := i.env.GetTypeHint(identifier.Name).(*types.TypeHint)
+	if !ok || !hint.IsUnion() {
+		return nil
+	}
+
+	covered := make(map[string]bool)
+	for _, arm := range stmt.Arms {
+		for _, pattern := range arm.Patterns {
+			if pattern.Kind != statement.WhenType {
+				continue
+			}
+			patternHint, err := i.resolveTypeHint(pattern.Type, false)
+			if err != nil {
+				return err
+			}
+			covered[patternHint.String()] = true
+		}
+	}
+
+	exhaustive := true
+	for _, member := range hint.Arguments {
+		exhaustive = exhaustive && covered[member.String()]
+	}
+	if exhaustive {
+		return nil
+	}
+
+	i.warnedWhens[stmt] = true
+	i.warn(fmt.Sprintf("Non-exhaustive when over '%s' of type %s, no arm matches %s (add an else arm)",
+		identifier.Name, hint, types.Inspect(subject)), stmt.GetLocation())
+	return nil
+}
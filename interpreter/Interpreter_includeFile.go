@@ -0,0 +1,201 @@
+package interpreter
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"zen/builtins/include"
+	"zen/lang/common"
+	"zen/lang/parsing"
+	"zen/lang/parsing/ast"
+	"zen/lang/parsing/statement"
+	"zen/runtime"
+	"zen/runtime/types"
+)
+
+// includeFile implements the include built-in.
+// Zen files return the value of a top-level return statement, or null. Files that return a value run in a new scope,
+// so their variables don't leak into the caller, while files without a return are executed in the scope include is called from.
+// Files of other formats are converted to a value by the loader registered for their extension, see include.RegisterLoader.
+// The path is relative to the file calling include
+func (i *Interpreter) includeFile(env *runtime.EnvironmentInterface, params map[string]types.Value) (types.Value, error) {
+	location := i.callStack[len(i.callStack)-1].Location
+	path := includePath(params["path"].String(), location)
+
+	if extension := filepath.Ext(path); extension != ".zen" {
+		return loadIncludedFile(path, extension, location)
+	}
+
+	for idx, included := range i.including {
+		if included == path {
+			return nil, &RuntimeError{
+				Message:  "Include cycle: " + strings.Join(append(append([]string{}, i.including[idx:]...), path), " -> "),
+				Location: location,
+			}
+		}
+	}
+
+	program, err := parseFile(path, location)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVisibility(program); err != nil {
+		return nil, err
+	}
+
+	i.including = append(i.including, path)
+	defer func() { i.including = i.including[:len(i.including)-1] }()
+
+	// The included file runs at its own top level: it may return, but can't break out of a loop it's included in
+	wasIncluding, wasInLoop := i.inInclude, i.inLoop
+	i.inInclude, i.inLoop = true, false
+	defer func() {
+		i.inInclude, i.inLoop = wasIncluding, wasInLoop
+	}()
+
+	if containsReturn(program.Statements) {
+		i.env.BeginScope()
+		defer i.env.EndScope()
+	}
+
+	for _, stmt := range program.Statements {
+		err := i.ExecuteStatement(stmt)
+		if signal, ok := err.(*returnSignal); ok {
+			if signal.Value == nil {
+				return types.NewNull(), nil
+			}
+			return signal.Value, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return types.NewNull(), nil
+}
+
+// containsReturn returns true if a return statement is among the statements or the blocks they contain,
+// leaving out the bodies of functions and classes, which return from their calls
+func containsReturn(stmts []ast.Statement) bool {
+	for _, stmt := range stmts {
+		var blocks [][]ast.Statement
+		switch s := stmt.(type) {
+		case *statement.ReturnStatmenet:
+			return true
+		case *statement.IfStatement:
+			blocks = append(blocks, s.PrimaryBlock, s.ElseBlock)
+			for _, elseIf := range s.ElseIfBlocks {
+				blocks = append(blocks, elseIf.Body)
+			}
+		case *statement.WhileStatement:
+			blocks = append(blocks, s.Body)
+		case *statement.ForStatement:
+			blocks = append(blocks, s.Body)
+		case *statement.ForInStatement:
+			blocks = append(blocks, s.Body)
+		case *statement.WhenStatement:
+			blocks = append(blocks, s.Else)
+			for _, arm := range s.Arms {
+				blocks = append(blocks, arm.Body)
+			}
+		case *statement.TryStatement:
+			blocks = append(blocks, s.Body, s.Finally)
+			for _, catch := range s.Catches {
+				blocks = append(blocks, catch.Body)
+			}
+		case *statement.SelectStatement:
+			blocks = append(blocks, s.Else)
+			for _, arm := range s.Arms {
+				blocks = append(blocks, arm.Body)
+			}
+		}
+		for _, block := range blocks {
+			if containsReturn(block) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// loadIncludedFile converts a file that isn't a zen file to a value, with the loader registered for its extension
+func loadIncludedFile(path string, extension string, location *common.SourceLocation) (types.Value, error) {
+	loader, ok := include.LoaderFor(extension)
+	if !ok {
+		return nil, &RuntimeError{
+			Message:  fmt.Sprintf("Cannot include %s, there is no loader for '%s' files", path, extension),
+			Location: location,
+		}
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, &RuntimeError{
+			Message:  fmt.Sprintf("Cannot read %s: %s", path, err),
+			Location: location,
+		}
+	}
+
+	value, err := loader(content)
+	if err != nil {
+		return nil, &RuntimeError{
+			Message:  fmt.Sprintf("Cannot include %s: %s", path, err),
+			Location: location,
+		}
+	}
+	return value, nil
+}
+
+// includePath resolves the path of an included file relative to the directory of the file including it
+func includePath(path string, location *common.SourceLocation) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(importingDir(location), path)
+}
+
+// includeClassMembers returns a class declaration with the members declared by the files included in its body added
+// after its own members, see statement.ClassInclude. Included files may include further files
+func (i *Interpreter) includeClassMembers(stmt *statement.ClassDeclaration, including []string) (*statement.ClassDeclaration, error) {
+	if len(stmt.Includes) == 0 {
+		return stmt, nil
+	}
+
+	merged := *stmt
+	merged.Uses = append([]*statement.TraitUse{}, stmt.Uses...)
+	merged.Fields = append([]*statement.VarDeclarationNode{}, stmt.Fields...)
+	merged.Methods = append([]*statement.FuncDeclaration{}, stmt.Methods...)
+	merged.Includes = nil
+
+	for _, classInclude := range stmt.Includes {
+		path := includePath(classInclude.Path, classInclude.Location)
+		for _, included := range including {
+			if included == path {
+				return nil, &RuntimeError{
+					Message:  fmt.Sprintf("Include cycle in class '%s': %s -> %s", stmt.Name, strings.Join(including, " -> "), path),
+					Location: classInclude.Location,
+				}
+			}
+		}
+
+		tokens, err := scanFile(path, classInclude.Location)
+		if err != nil {
+			return nil, err
+		}
+		members, syntaxErrors := parsing.NewParser(tokens, false).ParseClassMembers()
+		if len(syntaxErrors) > 0 {
+			return nil, syntaxError(path, syntaxErrors[0])
+		}
+
+		members.Name = stmt.Name
+		members, err = i.includeClassMembers(members, append(including, path))
+		if err != nil {
+			return nil, err
+		}
+
+		merged.Uses = append(merged.Uses, members.Uses...)
+		merged.Fields = append(merged.Fields, members.Fields...)
+		merged.Methods = append(merged.Methods, members.Methods...)
+	}
+	return &merged, nil
+}
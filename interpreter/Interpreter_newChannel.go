@@ -0,0 +1,46 @@
+package interpreter
+
+import (
+	"fmt"
+	"zen/lang/parsing/expression"
+	"zen/runtime/types"
+)
+
+// newChannel creates a channel, e.g. new Channel<int>() or new Channel<int>(10) for a channel buffering up to 10 values.
+// Channel is a built-in type like Array and Map, unless a class named Channel is declared
+func (i *Interpreter) newChannel(expr *expression.NewExpression) (types.Value, error) {
+	if len(expr.TypeArguments) != 1 || !expr.TypeArguments[0].IsType {
+		return nil, &RuntimeError{
+			Message:  "Channel expects the type of its values as type argument, e.g. new Channel<int>()",
+			Location: expr.GetLocation(),
+		}
+	}
+	element, err := i.resolveTypeParameter(expr.TypeArguments[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if len(expr.Arguments) > 1 {
+		return nil, &RuntimeError{
+			Message:  fmt.Sprintf("Channel expects at most 1 argument, the capacity of its buffer, got %d", len(expr.Arguments)),
+			Location: expr.GetLocation(),
+		}
+	}
+	capacity := 0
+	if len(expr.Arguments) == 1 {
+		value, err := i.EvaluateExpression(expr.Arguments[0])
+		if err != nil {
+			return nil, err
+		}
+		size, err := types.Convert(value, types.TypeInt)
+		if err != nil || size.(*types.Int).Value() < 0 {
+			return nil, &RuntimeError{
+				Message:  fmt.Sprintf("Invalid channel capacity %s, expected a non-negative integer", value),
+				Location: expr.Arguments[0].GetLocation(),
+			}
+		}
+		capacity = int(size.(*types.Int).Value())
+	}
+
+	return i.loop.NewChannel(element, capacity), nil
+}
@@ -0,0 +1,310 @@
+package interpreter
+
+import (
+	"fmt"
+	"zen/lang/parsing/ast"
+	"zen/lang/parsing/expression"
+	"zen/runtime/types"
+)
+
+// resolveTypeHint turns a type annotation from the AST into a types.TypeHint
+// A nil annotation resolves to 'any'
+func (i *Interpreter) resolveTypeHint(typeExpr ast.Expression, nullable bool) (*types.TypeHint, error) {
+	switch t := typeExpr.(type) {
+	case nil:
+		return types.NewTypeHint("any", nullable), nil
+	case *expression.BasicType:
+		switch t.Name {
+		case "Array":
+			return types.NewArrayTypeHint(types.NewTypeHint("any", false), types.Unbounded, nullable), nil
+		case "Map":
+			return types.NewMapTypeHint(types.NewTypeHint("any", false), types.NewTypeHint("any", false), nullable), nil
+		case "Range":
+			return types.NewTypeHint("Range", nullable), nil
+		case "Promise":
+			return types.NewTypeHint("Promise", nullable), nil
+		case "Timer":
+			return types.NewTypeHint("Timer", nullable), nil
+		case "Channel":
+			return types.NewTypeHint("Channel", nullable), nil
+		}
+		if typeValue, ok := i.lookupTypeValue(t.Name); ok {
+			return typeValue.Hint.WithNullable(nullable), nil
+		}
+		if _, ok := i.valueTypeArgument(t.Name); ok {
+			return nil, &RuntimeError{
+				Message:  fmt.Sprintf("'%s' is an integer, expected a type", t.Name),
+				Location: t.GetLocation(),
+			}
+		}
+		if class, ok := i.lookupClass(t.Name); ok {
+			return types.NewClassTypeHint(class, nullable), nil
+		}
+		if iface, ok := i.lookupInterface(t.Name); ok {
+			return types.NewInterfaceTypeHint(iface, nullable), nil
+		}
+		if !types.IsPrimitiveTypeName(t.Name) {
+			return nil, &RuntimeError{
+				Message:  fmt.Sprintf("Unknown type '%s'", t.Name),
+				Location: t.GetLocation(),
+			}
+		}
+		return types.NewTypeHint(t.Name, nullable), nil
+	case *expression.FunctionType:
+		params := make([]*types.TypeHint, len(t.ParameterTypes))
+		for idx, paramType := range t.ParameterTypes {
+			param, err := i.resolveTypeHint(paramType, false)
+			if err != nil {
+				return nil, err
+			}
+			params[idx] = param
+		}
+		returns, err := i.resolveTypeHint(t.ReturnType, false)
+		if err != nil {
+			return nil, err
+		}
+		return types.NewFunctionTypeHint(params, returns, nullable), nil
+	case *expression.ParametricType:
+		return i.resolveParametricType(t, nullable)
+	case *expression.TupleType:
+		names := make([]string, len(t.Elements))
+		elements := make([]*types.TypeHint, len(t.Elements))
+		for idx, element := range t.Elements {
+			hint, err := i.resolveTypeHint(element.Type, element.IsNullable)
+			if err != nil {
+				return nil, err
+			}
+			names[idx] = element.Name
+			elements[idx] = hint
+		}
+		return types.NewTupleTypeHint(names, elements, nullable), nil
+	case *expression.UnionType:
+		members := make([]*types.TypeHint, len(t.Types))
+		for idx, memberType := range t.Types {
+			member, err := i.resolveTypeHint(memberType, false)
+			if err != nil {
+				return nil, err
+			}
+			members[idx] = member
+		}
+		return types.NewUnionTypeHint(members, nullable), nil
+	default:
+		return nil, &RuntimeError{
+			Message:  fmt.Sprintf("Unsupported type '%s'", typeExpr.String(0)),
+			Location: typeExpr.GetLocation(),
+		}
+	}
+}
+
+// resolveParametricType resolves a parametric type such as Array<int, 3>
+func (i *Interpreter) resolveParametricType(t *expression.ParametricType, nullable bool) (*types.TypeHint, error) {
+	switch t.BaseType {
+	case "Array":
+		return i.resolveArrayType(t, nullable)
+	case "Map":
+		return i.resolveMapType(t, nullable)
+	case "Promise", "Channel":
+		return i.resolveElementType(t, nullable)
+	}
+
+	if iface, ok := i.lookupInterface(t.BaseType); ok {
+		return i.resolveInterfaceType(iface, t, nullable)
+	}
+
+	class, ok := i.lookupClass(t.BaseType)
+	if !ok {
+		return nil, &RuntimeError{
+			Message:  fmt.Sprintf("Unknown type '%s'", t.BaseType),
+			Location: t.GetLocation(),
+		}
+	}
+	if len(class.TypeParameters) == 0 {
+		return nil, &RuntimeError{
+			Message:  fmt.Sprintf("Class '%s' is not generic and takes no type arguments", class.Name),
+			Location: t.GetLocation(),
+		}
+	}
+
+	args, err := i.resolveTypeArguments(t.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	if err := i.checkTypeArguments(class.Name, class.TypeParameters, args, t.GetLocation()); err != nil {
+		return nil, err
+	}
+	hint := types.NewClassTypeHint(class, nullable)
+	hint.TypeArguments = args
+	return hint, nil
+}
+
+// resolveInterfaceType resolves a generic interface given type arguments, e.g. BracketGet<int, int>, see instantiateInterface
+func (i *Interpreter) resolveInterfaceType(iface *types.Interface, t *expression.ParametricType, nullable bool) (*types.TypeHint, error) {
+	if len(iface.TypeParameters) == 0 {
+		return nil, &RuntimeError{
+			Message:  fmt.Sprintf("Interface '%s' is not generic and takes no type arguments", iface.Name),
+			Location: t.GetLocation(),
+		}
+	}
+
+	args, err := i.resolveTypeArguments(t.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	instance, err := i.instantiateInterface(iface, args, t.GetLocation())
+	if err != nil {
+		return nil, err
+	}
+	hint := types.NewInterfaceTypeHint(instance, nullable)
+	hint.TypeArguments = args
+	return hint, nil
+}
+
+// resolveArrayType resolves Array<T> and Array<T, SIZE>, where SIZE is an integer, INF,
+// or a type parameter of a generic class or function given an integer
+func (i *Interpreter) resolveArrayType(t *expression.ParametricType, nullable bool) (*types.TypeHint, error) {
+	if len(t.Parameters) > 2 || !t.Parameters[0].IsType {
+		return nil, &RuntimeError{
+			Message:  fmt.Sprintf("Invalid type '%s', expected Array<TYPE, SIZE>", t.String(0)),
+			Location: t.GetLocation(),
+		}
+	}
+
+	element, err := i.resolveTypeParameter(t.Parameters[0])
+	if err != nil {
+		return nil, err
+	}
+
+	size := types.Unbounded
+	if len(t.Parameters) == 2 {
+		param := t.Parameters[1]
+		switch {
+		case param.IsType && param.Value == "INF":
+			size = types.Unbounded
+		case !param.IsType && param.Value.(int64) >= 0:
+			size = int(param.Value.(int64))
+		case param.IsType && i.isTypeParameterSize(param):
+			size, _ = i.valueTypeArgument(param.Value.(string))
+		case param.IsType && i.isTypeParameter(param):
+			typeValue, _ := i.lookupTypeValue(param.Value.(string))
+			return nil, &RuntimeError{
+				Message: fmt.Sprintf("Invalid array size in '%s', expected a positive integer or INF for %s, got type %s",
+					t.String(0), typeValue.Hint.BoundTo, typeValue),
+				Location: param.Location,
+			}
+		default:
+			return nil, &RuntimeError{
+				Message:  fmt.Sprintf("Invalid array size in '%s', expected a positive integer or INF", t.String(0)),
+				Location: param.Location,
+			}
+		}
+	}
+
+	return types.NewArrayTypeHint(element, size, nullable), nil
+}
+
+// isTypeParameterSize returns true if an array size names a type parameter given a positive integer or INF.
+// While its class or function is declared, the type parameter isn't bound yet and the array is unbounded
+func (i *Interpreter) isTypeParameterSize(param expression.Parameter) bool {
+	name, ok := param.Value.(string)
+	if !ok {
+		return false
+	}
+	if typeValue, ok := i.lookupTypeValue(name); ok {
+		return typeValue.Hint.Parameter != nil
+	}
+	size, ok := i.valueTypeArgument(name)
+	return ok && (size >= 0 || size == types.Unbounded)
+}
+
+// isTypeParameter returns true if a parameter of a parametric type names a type parameter given a type
+func (i *Interpreter) isTypeParameter(param expression.Parameter) bool {
+	name, ok := param.Value.(string)
+	if !ok {
+		return false
+	}
+	typeValue, ok := i.lookupTypeValue(name)
+	return ok && typeValue.Hint.BoundTo != nil
+}
+
+// resolveMapType resolves Map<K, V>
+func (i *Interpreter) resolveMapType(t *expression.ParametricType, nullable bool) (*types.TypeHint, error) {
+	if len(t.Parameters) != 2 || !t.Parameters[0].IsType || !t.Parameters[1].IsType {
+		return nil, &RuntimeError{
+			Message:  fmt.Sprintf("Invalid type '%s', expected Map<KEY, VALUE>", t.String(0)),
+			Location: t.GetLocation(),
+		}
+	}
+
+	key, err := i.resolveTypeParameter(t.Parameters[0])
+	if err != nil {
+		return nil, err
+	}
+	value, err := i.resolveTypeParameter(t.Parameters[1])
+	if err != nil {
+		return nil, err
+	}
+	return types.NewMapTypeHint(key, value, nullable), nil
+}
+
+// resolveTypeParameter resolves a type given as a parameter of a parametric type
+func (i *Interpreter) resolveTypeParameter(param expression.Parameter) (*types.TypeHint, error) {
+	switch v := param.Value.(type) {
+	case string:
+		return i.resolveTypeHint(expression.NewBasicType(v, param.Location), param.Nullable)
+	case ast.Expression:
+		return i.resolveTypeHint(v, param.Nullable)
+	default:
+		return nil, &RuntimeError{
+			Message:  fmt.Sprintf("Expected a type, got %v", v),
+			Location: param.Location,
+		}
+	}
+}
+
+// lookupClass returns the class with the given name, if one is defined in the current scope chain
+func (i *Interpreter) lookupClass(name string) (*types.Class, bool) {
+	value, err := i.env.Get(name)
+	if err != nil {
+		return nil, false
+	}
+	class, ok := value.(*types.Class)
+	return class, ok
+}
+
+// lookupTypeValue returns the type a type parameter with the given name is bound to in the current scope chain
+func (i *Interpreter) lookupTypeValue(name string) (*types.TypeValue, bool) {
+	value, err := i.env.Get(name)
+	if err != nil {
+		return nil, false
+	}
+	typeValue, ok := value.(*types.TypeValue)
+	return typeValue, ok
+}
+
+// lookupInterface returns the interface with the given name, if one is defined in the current scope chain
+func (i *Interpreter) lookupInterface(name string) (*types.Interface, bool) {
+	value, err := i.env.Get(name)
+	if err != nil {
+		return nil, false
+	}
+	iface, ok := value.(*types.Interface)
+	return iface, ok
+}
+
+// resolveElementType resolves Promise<T>, the type of the value the promise is fulfilled with, and Channel<T>, the type of the values passed on the channel
+func (i *Interpreter) resolveElementType(t *expression.ParametricType, nullable bool) (*types.TypeHint, error) {
+	if len(t.Parameters) != 1 || !t.Parameters[0].IsType {
+		return nil, &RuntimeError{
+			Message:  fmt.Sprintf("Invalid type '%s', expected %s<TYPE>", t.String(0), t.BaseType),
+			Location: t.GetLocation(),
+		}
+	}
+
+	value, err := i.resolveTypeParameter(t.Parameters[0])
+	if err != nil {
+		return nil, err
+	}
+	hint := types.NewTypeHint(t.BaseType, nullable)
+	hint.Arguments = []*types.TypeHint{value}
+	return hint, nil
+}
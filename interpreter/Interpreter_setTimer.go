@@ -0,0 +1,77 @@
+package interpreter
+
+import (
+	"fmt"
+	"time"
+	"zen/runtime"
+	"zen/runtime/async"
+	"zen/runtime/types"
+)
+
+// sleep implements the async sleep built-in, whose promise is fulfilled with null once the given number of milliseconds has passed
+func (i *Interpreter) sleep(env *runtime.EnvironmentInterface, params map[string]types.Value) (types.Value, error) {
+	delay, err := timerDelay(params["ms"], false)
+	if err != nil {
+		return nil, err
+	}
+
+	promise := i.loop.NewPromise()
+	i.loop.SetTimeout(delay, func() {
+		promise.Resolve(types.NewNull())
+	})
+	return promise, nil
+}
+
+// setTimeout implements the setTimeout built-in, which calls a function once after the given number of milliseconds.
+// Returns the timer, which clearTimer cancels
+func (i *Interpreter) setTimeout(env *runtime.EnvironmentInterface, params map[string]types.Value) (types.Value, error) {
+	return i.setTimer("setTimeout", params, false)
+}
+
+// setInterval implements the setInterval built-in, which calls a function every given number of milliseconds until the timer it returns is cancelled
+func (i *Interpreter) setInterval(env *runtime.EnvironmentInterface, params map[string]types.Value) (types.Value, error) {
+	return i.setTimer("setInterval", params, true)
+}
+
+// clearTimer implements the clearTimer built-in, which cancels a timer so its function isn't called anymore
+func (i *Interpreter) clearTimer(env *runtime.EnvironmentInterface, params map[string]types.Value) (types.Value, error) {
+	params["timer"].(*async.Timer).Cancel()
+	return nil, nil
+}
+
+// setTimer schedules the callback parameter to be called after the ms parameter, or every ms if repeat is true.
+// Each call runs as a new task, like a call to an async function, and an error it fails with is reported like an unhandled rejection
+func (i *Interpreter) setTimer(name string, params map[string]types.Value, repeat bool) (types.Value, error) {
+	location := i.callStack[len(i.callStack)-1].Location
+	callback := params["callback"]
+	if !types.IsCallable(callback) {
+		return nil, fmt.Errorf("Cannot schedule value of type %s, expected a function", callback.Type())
+	}
+	delay, err := timerDelay(params["ms"], repeat)
+	if err != nil {
+		return nil, err
+	}
+
+	state := i.newTaskState()
+	run := func() {
+		i.startAsync(name, state, func() (types.Value, error) {
+			return i.callFunction(callback, nil, nil, location)
+		})
+	}
+	if repeat {
+		return i.loop.SetInterval(delay, run), nil
+	}
+	return i.loop.SetTimeout(delay, run), nil
+}
+
+// timerDelay converts a number of milliseconds to a duration. Intervals must be positive, so they can't fire endlessly without time passing
+func timerDelay(ms types.Value, interval bool) (time.Duration, error) {
+	value := ms.(*types.Int).Value()
+	if interval && value <= 0 {
+		return 0, fmt.Errorf("Invalid interval %d, expected a positive number of milliseconds", value)
+	}
+	if value < 0 {
+		return 0, fmt.Errorf("Invalid delay %d, expected a non-negative number of milliseconds", value)
+	}
+	return time.Duration(value) * time.Millisecond, nil
+}
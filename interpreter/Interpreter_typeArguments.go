@@ -0,0 +1,312 @@
+package interpreter
+
+import (
+	"fmt"
+	"zen/lang/common"
+	"zen/lang/parsing/ast"
+	"zen/lang/parsing/expression"
+	"zen/runtime/environment"
+	"zen/runtime/types"
+)
+
+// declareTypeParameters returns the type parameters of a generic class or function
+// and a scope in which each of them is bound to a placeholder accepting any value, see types.NewTypePlaceholder.
+// The members of the class or the function are declared in that scope, so their types can refer to the type parameters.
+// Value parameters must be of type int, e.g. N: int
+func (i *Interpreter) declareTypeParameters(owner string, params []*expression.TypeParameter) ([]*types.TypeParameter, *environment.Scope, error) {
+	scope := environment.NewScope(i.env.CurrentScope())
+	declared := make([]*types.TypeParameter, len(params))
+	for idx, param := range params {
+		integer := false
+		if param.Type != nil {
+			if basic, ok := param.Type.(*expression.BasicType); !ok || basic.Name != "int" {
+				return nil, nil, &RuntimeError{
+					Message:  fmt.Sprintf("Value type parameter %s of '%s' must be of type int, got %s", param.Name, owner, param.Type.String(0)),
+					Location: param.Location,
+				}
+			}
+			integer = true
+		}
+
+		declared[idx] = types.NewTypeParameter(param.Name, integer, owner)
+		if err := scope.DefineConst(param.Name, types.NewTypePlaceholder(declared[idx])); err != nil {
+			return nil, nil, &RuntimeError{
+				Message:  err.Error(),
+				Location: param.Location,
+			}
+		}
+	}
+	return declared, scope, nil
+}
+
+// resolveTypeArguments resolves the type arguments of a generic class or function call, e.g. int, 3 in Grid<int, 3>(),
+// to a types.TypeValue for types and an int for integers. INF is the integer types.Unbounded,
+// and the value type parameters of the enclosing generic class or function pass on their integer.
+// Returns nil without type arguments, so they are inferred
+func (i *Interpreter) resolveTypeArguments(params []expression.Parameter) ([]types.Value, error) {
+	if len(params) == 0 {
+		return nil, nil
+	}
+
+	args := make([]types.Value, len(params))
+	for idx, param := range params {
+		if !param.IsType {
+			args[idx] = types.NewInt(int32(param.Value.(int64)))
+			continue
+		}
+
+		if name, ok := param.Value.(string); ok {
+			if name == "INF" {
+				args[idx] = types.NewInt(types.Unbounded)
+				continue
+			}
+			if size, ok := i.valueTypeArgument(name); ok {
+				args[idx] = types.NewInt(int32(size))
+				continue
+			}
+		}
+
+		hint, err := i.resolveTypeParameter(param)
+		if err != nil {
+			return nil, err
+		}
+		args[idx] = types.NewTypeValue(hint)
+	}
+	return args, nil
+}
+
+// valueTypeArgument returns the integer a value type parameter is bound to in the current scope, see bindTypeArguments
+func (i *Interpreter) valueTypeArgument(name string) (int, bool) {
+	value, err := i.env.Get(name)
+	if err != nil {
+		return 0, false
+	}
+	size, ok := value.(int32)
+	return int(size), ok
+}
+
+// checkTypeArguments verifies that the number and kind of type arguments match the type parameters of a generic class or function.
+// Value parameters only take integers, see types.TypeParameter.Integer
+func (i *Interpreter) checkTypeArguments(owner string, params []*types.TypeParameter, args []types.Value, location *common.SourceLocation) error {
+	if len(args) != len(params) {
+		return &RuntimeError{
+			Message:  fmt.Sprintf("'%s' expects %d type argument(s), got %d", owner, len(params), len(args)),
+			Location: location,
+		}
+	}
+
+	for idx, param := range params {
+		if !param.Integer {
+			continue
+		}
+		switch arg := args[idx].(type) {
+		case *types.TypeValue:
+			if arg.Hint.Parameter != nil {
+				// The placeholder of a type parameter while its class or function is being declared
+				continue
+			}
+			return &RuntimeError{
+				Message:  fmt.Sprintf("Invalid type argument for %s: expected an integer, got type %s", param, arg),
+				Location: location,
+			}
+		case *types.Int:
+			if arg.Value() == types.Unbounded {
+				return &RuntimeError{
+					Message:  fmt.Sprintf("Invalid type argument for %s: expected an integer, got INF", param),
+					Location: location,
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// bindTypeArguments defines each type parameter in the current scope: types as a types.TypeValue
+// whose checks name the parameter they were given for, and integers as an int constant usable in code
+func (i *Interpreter) bindTypeArguments(params []*types.TypeParameter, args []types.Value) error {
+	for idx, param := range params {
+		var value interface{}
+		if typeValue, ok := args[idx].(*types.TypeValue); ok {
+			value = types.NewTypeValue(typeValue.Hint.BindTo(param))
+		} else {
+			value = types.ToGoValue(args[idx])
+		}
+		if err := i.env.DefineConst(param.Name, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyTypeArguments sets the type arguments of a new object for a generic class it is an instance of,
+// and resolves the types of the fields declared by that class with them.
+// The type the class extends is resolved with the type arguments as well, e.g. Box<T> in class Crate<T> extends Box<T>,
+// which gives the type arguments of the superclass, or the type of the Array or Map the object wraps
+func (i *Interpreter) applyTypeArguments(object *types.Object, class *types.Class, args []types.Value, location *common.SourceLocation) error {
+	previous := i.env.BeginScopeFrom(class.Closure)
+	defer i.env.RestoreScope(previous)
+
+	if len(class.TypeParameters) > 0 {
+		if err := i.checkTypeArguments(class.Name, class.TypeParameters, args, location); err != nil {
+			return err
+		}
+		object.SetTypeArguments(class, args)
+		if err := i.bindTypeArguments(class.TypeParameters, args); err != nil {
+			return err
+		}
+	}
+
+	for _, field := range class.Fields {
+		if field.TypeExpression == nil {
+			continue
+		}
+		hint, err := i.resolveTypeHint(field.TypeExpression, field.Type.Nullable)
+		if err != nil {
+			return err
+		}
+		object.SetFieldType(field, hint)
+	}
+
+	// Classes extending a class that isn't generic, or a built-in type without type parameters, have nothing left to resolve
+	if class.Extends == nil || (class.Superclass != nil && !class.Superclass.IsGeneric()) ||
+		(class.Superclass == nil && len(class.TypeParameters) == 0) {
+		return nil
+	}
+
+	hint, err := i.resolveTypeHint(class.Extends, false)
+	if err != nil {
+		return err
+	}
+	if hint.Class != nil {
+		return i.applyTypeArguments(object, hint.Class, hint.TypeArguments, location)
+	}
+	object.SetNative(hint.ZeroValue())
+	return nil
+}
+
+// inferTypeArguments infers the type arguments of a generic class or function from the arguments passed for parameters
+// of the given types, e.g. T is int in first<T>(xs: Array<T>) called with an Array<int>.
+// Type parameters that can't be inferred are any, except value parameters, which must be given explicitly
+func (i *Interpreter) inferTypeArguments(params []*types.TypeParameter, paramTypes []ast.Expression, args []types.Value, location *common.SourceLocation) ([]types.Value, error) {
+	inferred := make(map[string]types.Value)
+	for idx, arg := range args {
+		if idx >= len(paramTypes) {
+			break
+		}
+		if arg.Type() != types.TypeNull {
+			inferTypeArgument(params, paramTypes[idx], types.HintOf(arg), inferred)
+		}
+	}
+
+	typeArgs := make([]types.Value, len(params))
+	for idx, param := range params {
+		if arg, ok := inferred[param.Name]; ok {
+			typeArgs[idx] = arg
+			continue
+		}
+		if param.Integer {
+			return nil, &RuntimeError{
+				Message:  fmt.Sprintf("Cannot infer %s from the arguments, pass the type arguments explicitly", param),
+				Location: location,
+			}
+		}
+		typeArgs[idx] = types.NewTypeValue(types.NewTypeHint("any", false))
+	}
+	return typeArgs, nil
+}
+
+// inferTypeArgument matches a declared type against the type of an argument,
+// adding the type parameters it refers to that aren't inferred yet, or only inferred as any
+func inferTypeArgument(params []*types.TypeParameter, typeExpr ast.Expression, hint *types.TypeHint, inferred map[string]types.Value) {
+	switch t := typeExpr.(type) {
+	case *expression.BasicType:
+		inferParameter(params, t.Name, types.NewTypeValue(hint), inferred)
+	case *expression.ParametricType:
+		var actual []types.Value
+		switch {
+		case t.BaseType == "Array" && hint.Name == "Array":
+			actual = []types.Value{types.NewTypeValue(hint.Arguments[0])}
+			if hint.Size != types.Unbounded {
+				actual = append(actual, types.NewInt(int32(hint.Size)))
+			}
+		case t.BaseType == "Map" && hint.Name == "Map":
+			actual = []types.Value{types.NewTypeValue(hint.Arguments[0]), types.NewTypeValue(hint.Arguments[1])}
+		case hint.Class != nil && hint.Class.Name == t.BaseType:
+			actual = hint.TypeArguments
+		}
+
+		for idx, param := range t.Parameters {
+			if idx >= len(actual) {
+				break
+			}
+			switch v := param.Value.(type) {
+			case string:
+				inferParameter(params, v, actual[idx], inferred)
+			case ast.Expression:
+				if typeValue, ok := actual[idx].(*types.TypeValue); ok {
+					inferTypeArgument(params, v, typeValue.Hint, inferred)
+				}
+			}
+		}
+	}
+}
+
+// inferParameter infers the type parameter with the given name, if there is one, unless it was inferred from a previous argument
+func inferParameter(params []*types.TypeParameter, name string, value types.Value, inferred map[string]types.Value) {
+	for _, param := range params {
+		if param.Name != name {
+			continue
+		}
+		if param.Integer && value.Type() != types.TypeInt {
+			return
+		}
+		if existing, ok := inferred[name].(*types.TypeValue); ok && existing.Hint.Name != "any" {
+			return
+		}
+		if _, ok := inferred[name].(*types.Int); ok {
+			return
+		}
+		inferred[name] = value
+	}
+}
+
+// parameterTypes returns the declared types of the parameters of a function
+func parameterTypes(fn *types.UserFunction) []ast.Expression {
+	paramTypes := make([]ast.Expression, len(fn.Parameters))
+	for idx, param := range fn.Parameters {
+		paramTypes[idx] = param.Type
+	}
+	return paramTypes
+}
+
+// bindFunctionTypeArguments binds the type parameters of a generic function in the scope of a call,
+// to the given type arguments or else the ones inferred from the arguments
+func (i *Interpreter) bindFunctionTypeArguments(fn *types.UserFunction, args []types.Value, typeArgs []types.Value, location *common.SourceLocation) error {
+	if len(fn.TypeParameters) == 0 {
+		if len(typeArgs) > 0 {
+			return &RuntimeError{
+				Message:  fmt.Sprintf("Function '%s' is not generic and takes no type arguments", fn.Name),
+				Location: location,
+			}
+		}
+		return nil
+	}
+
+	if typeArgs == nil {
+		inferred, err := i.inferTypeArguments(fn.TypeParameters, parameterTypes(fn), args, location)
+		if err != nil {
+			return err
+		}
+		typeArgs = inferred
+	}
+	if err := i.checkTypeArguments(fn.Name, fn.TypeParameters, typeArgs, location); err != nil {
+		return err
+	}
+	if err := i.bindTypeArguments(fn.TypeParameters, typeArgs); err != nil {
+		return &RuntimeError{
+			Message:  err.Error(),
+			Location: location,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,288 @@
+package interpreter
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"zen/lang/common"
+	"zen/lang/lexing"
+	"zen/lang/parsing"
+	"zen/lang/parsing/ast"
+	"zen/lang/parsing/statement"
+	"zen/runtime/environment"
+)
+
+// PackageFile is the file declaring the name of a package at its root, e.g. package GameEngine
+const PackageFile = "package.zen"
+
+// Module is a zen file loaded by an import statement.
+// Each module is executed once, in its own top-level scope whose parent holds the built-ins, see loadModule
+type Module struct {
+	// Name of the module, its import path, e.g. GameEngine/Core/Vector2
+	Name string
+	// Path of the file, absolute
+	Path string
+	// Scope holding the top-level declarations of the module
+	Scope *environment.Scope
+	// Program of the module, whose declarations are executed again by spawned isolates, see evaluateSpawn and loadModule
+	Program *ast.ProgramNode
+}
+
+// Symbols returns the names of the top-level declarations of the module that can be imported, in the order they were declared.
+// Private declarations are excluded
+func (m *Module) Symbols() []string {
+	symbols := make([]string, 0)
+	for _, name := range m.Scope.Names() {
+		if info, _ := m.Scope.GetLocal(name); !info.IsPrivate() {
+			symbols = append(symbols, name)
+		}
+	}
+	return symbols
+}
+
+// resolveImport loads the modules an import path refers to, which is either the module in the file <path>.zen
+// or the modules directly in the directory <path>, relative to the root of the package named by the first segment.
+// An import of just the package name refers to the modules at its root.
+// Files starting with an underscore and package.zen files are never modules.
+// Returns true for directories, which are namespaces
+func (i *Interpreter) resolveImport(stmt *statement.ImportStatement) ([]*Module, bool, error) {
+	root, err := i.findPackageRoot(stmt.Path[0], importingDir(stmt.GetLocation()), stmt.GetLocation())
+	if err != nil {
+		return nil, false, err
+	}
+
+	for _, name := range stmt.Path[1:] {
+		if strings.HasPrefix(name, "_") {
+			return nil, false, &RuntimeError{
+				Message:  fmt.Sprintf("Cannot import '%s', files starting with an underscore are ignored", stmt.PathString()),
+				Location: stmt.GetLocation(),
+			}
+		}
+	}
+
+	target := filepath.Join(append([]string{root}, stmt.Path[1:]...)...)
+	if info, err := os.Stat(target + ".zen"); err == nil && !info.IsDir() && len(stmt.Path) > 1 {
+		module, err := i.loadModule(target+".zen", stmt.PathString(), stmt.GetLocation())
+		if err != nil {
+			return nil, false, err
+		}
+		return []*Module{module}, false, nil
+	}
+
+	files, err := namespaceFiles(target)
+	if err != nil {
+		return nil, false, &RuntimeError{
+			Message:  fmt.Sprintf("Cannot find module or namespace '%s' in package '%s' at %s", stmt.PathString(), stmt.Path[0], root),
+			Location: stmt.GetLocation(),
+		}
+	}
+
+	modules := make([]*Module, len(files))
+	for idx, file := range files {
+		name := stmt.PathString() + "/" + strings.TrimSuffix(file, ".zen")
+		if modules[idx], err = i.loadModule(filepath.Join(target, file), name, stmt.GetLocation()); err != nil {
+			return nil, false, err
+		}
+	}
+	return modules, true, nil
+}
+
+// namespaceFiles returns the names of the module files directly in a directory, sorted by name
+func namespaceFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]string, 0)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".zen") || strings.HasPrefix(name, "_") || name == PackageFile {
+			continue
+		}
+		files = append(files, name)
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// importingDir returns the directory imports and includes are resolved from: the directory of the file being executed,
+// or the working directory for code that doesn't come from a file
+func importingDir(location *common.SourceLocation) string {
+	if location != nil {
+		if file, ok := location.Source.(*common.FileSourceCode); ok {
+			if path, err := filepath.Abs(file.Path); err == nil {
+				return filepath.Dir(path)
+			}
+		}
+	}
+	dir, _ := os.Getwd()
+	return dir
+}
+
+// findPackageRoot returns the root directory of the package with the given name, which holds a package.zen file declaring it.
+// The directory imports are resolved from and each of its parents are searched, as well as a subdirectory named after the package in each of them
+func (i *Interpreter) findPackageRoot(name string, dir string, location *common.SourceLocation) (string, error) {
+	for current := dir; ; {
+		for _, candidate := range []string{current, filepath.Join(current, name)} {
+			declared, err := i.packageName(filepath.Join(candidate, PackageFile), location)
+			if err != nil {
+				return "", err
+			}
+			if declared == name {
+				return candidate, nil
+			}
+		}
+
+		parent := filepath.Dir(current)
+		if parent == current {
+			break
+		}
+		current = parent
+	}
+
+	return "", &RuntimeError{
+		Message:  fmt.Sprintf("Cannot find package '%s', no %s declaring it was found from %s", name, PackageFile, dir),
+		Location: location,
+	}
+}
+
+// packageName returns the name declared by a package.zen file, or an empty string if there is no such file
+func (i *Interpreter) packageName(path string, location *common.SourceLocation) (string, error) {
+	if name, ok := i.packageNames[path]; ok {
+		return name, nil
+	}
+
+	name := ""
+	if _, err := os.Stat(path); err == nil {
+		program, err := parseFile(path, location)
+		if err != nil {
+			return "", err
+		}
+		for _, stmt := range program.Statements {
+			if declaration, ok := stmt.(*statement.PackageDeclaration); ok {
+				name = declaration.Name
+				break
+			}
+		}
+	}
+
+	i.packageNames[path] = name
+	return name, nil
+}
+
+// loadModule executes the module in the given file, unless it was loaded before.
+// The module is executed in its own top-level scope, and calls it defers are made once it's loaded.
+// A spawned isolate only executes the declarations of the modules the isolate that spawned it has loaded, see executeDeclarations,
+// so their other top-level statements, such as prints, run once per program rather than once per spawn.
+// Importing a module that is still being loaded is an import cycle, reported with the chain of imports leading to it
+func (i *Interpreter) loadModule(path string, name string, location *common.SourceLocation) (module *Module, err error) {
+	if module, ok := i.modules[path]; ok {
+		return module, nil
+	}
+
+	for idx, loading := range i.loading {
+		if loading.Path == path {
+			chain := make([]string, 0, len(i.loading)-idx+1)
+			for _, m := range i.loading[idx:] {
+				chain = append(chain, m.Name)
+			}
+			return nil, &RuntimeError{
+				Message:  "Import cycle: " + strings.Join(append(chain, name), " -> "),
+				Location: location,
+			}
+		}
+	}
+
+	program, err := parseFile(path, location)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVisibility(program); err != nil {
+		return nil, err
+	}
+
+	if err := i.pushFrame("<module "+name+">", location); err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = i.attachStack(err)
+		i.popFrame()
+	}()
+
+	previous := i.env.BeginScopeFrom(i.env.GlobalScope())
+	defer i.env.RestoreScope(previous)
+
+	module = &Module{Name: name, Path: path, Scope: i.env.CurrentScope(), Program: program}
+	i.loading = append(i.loading, module)
+	defer func() { i.loading = i.loading[:len(i.loading)-1] }()
+
+	if constants, ok := i.spawnedModules[path]; ok {
+		if err := i.executeDeclarations(program, constants, location); err != nil {
+			return nil, err
+		}
+		i.modules[path] = module
+		return module, nil
+	}
+
+	i.beginDeferred()
+	for _, stmt := range program.Statements {
+		if err = i.ExecuteStatement(stmt); err != nil {
+			break
+		}
+	}
+	if err = i.runDeferred(err); err != nil {
+		return nil, err
+	}
+
+	i.modules[path] = module
+	return module, nil
+}
+
+// parseFile reads and parses a zen file loaded by an import or include, reporting its first syntax error at its location in the file
+func parseFile(path string, location *common.SourceLocation) (*ast.ProgramNode, error) {
+	tokens, err := scanFile(path, location)
+	if err != nil {
+		return nil, err
+	}
+
+	program, syntaxErrors := parsing.NewParser(tokens, false).Parse()
+	if len(syntaxErrors) > 0 {
+		return nil, syntaxError(path, syntaxErrors[0])
+	}
+	return program, nil
+}
+
+// scanFile reads a zen file and returns its tokens
+func scanFile(path string, location *common.SourceLocation) ([]lexing.Token, error) {
+	code, err := os.ReadFile(path)
+	if err != nil {
+		return nil, &RuntimeError{
+			Message:  fmt.Sprintf("Cannot read %s: %s", path, err),
+			Location: location,
+		}
+	}
+
+	lexer := lexing.NewLexer(common.NewFileSourceCode(path, string(code)))
+	tokens, err := lexer.Scan()
+	if err != nil {
+		if len(lexer.Errors) > 0 {
+			return nil, syntaxError(path, &lexer.Errors[0])
+		}
+		return nil, &RuntimeError{
+			Message:  fmt.Sprintf("Syntax error in %s: %s", path, err),
+			Location: location,
+		}
+	}
+	return tokens, nil
+}
+
+// syntaxError converts a syntax error in a file loaded by an import or include to a runtime error
+func syntaxError(path string, err *common.SyntaxError) error {
+	return &RuntimeError{
+		Message:  fmt.Sprintf("Syntax error in %s: %s", path, err.Message),
+		Location: err.Location,
+	}
+}
@@ -0,0 +1,70 @@
+package interpreter
+
+import (
+	"zen/runtime/async"
+	"zen/runtime/environment"
+	"zen/runtime/types"
+)
+
+// taskState is the execution state of a task, which the interpreter switches out whenever another task runs, see startTask
+type taskState struct {
+	scope         *environment.Scope
+	callStack     []*CallFrame
+	deferred      [][]*deferredCall
+	inFunction    bool
+	inLoop        bool
+	inInclude     bool
+	inAsync       bool
+	memberAliases map[string]string
+	currentClass  *types.Class
+	whenSubjects  []types.Value
+	task          *async.Task
+}
+
+// saveTaskState returns the execution state of the task being executed
+func (i *Interpreter) saveTaskState() *taskState {
+	return &taskState{
+		scope:         i.env.CurrentScope(),
+		callStack:     i.callStack,
+		deferred:      i.deferred,
+		inFunction:    i.inFunction,
+		inLoop:        i.inLoop,
+		inInclude:     i.inInclude,
+		inAsync:       i.inAsync,
+		memberAliases: i.memberAliases,
+		currentClass:  i.currentClass,
+		whenSubjects:  i.whenSubjects,
+		task:          i.task,
+	}
+}
+
+// restoreTaskState continues executing a task in the state it was saved in
+func (i *Interpreter) restoreTaskState(state *taskState) {
+	i.env.RestoreScope(state.scope)
+	i.callStack = state.callStack
+	i.deferred = state.deferred
+	i.inFunction = state.inFunction
+	i.inLoop = state.inLoop
+	i.inInclude = state.inInclude
+	i.inAsync = state.inAsync
+	i.memberAliases = state.memberAliases
+	i.currentClass = state.currentClass
+	i.whenSubjects = state.whenSubjects
+	i.task = state.task
+}
+
+// startTask runs a new task until it first suspends or completes, then continues the task that started it
+func (i *Interpreter) startTask(task *async.Task) {
+	state := i.saveTaskState()
+	i.loop.Start(task)
+	i.restoreTaskState(state)
+}
+
+// newTaskState returns the initial state of a task started by an async call: outside of any function,
+// in the current scope and with a copy of the current call stack, so errors show where the call was made
+func (i *Interpreter) newTaskState() *taskState {
+	return &taskState{
+		scope:     i.env.CurrentScope(),
+		callStack: append([]*CallFrame{}, i.callStack...),
+	}
+}
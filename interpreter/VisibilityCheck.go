@@ -0,0 +1,495 @@
+package interpreter
+
+import (
+	"fmt"
+	"zen/lang/parsing/ast"
+	"zen/lang/parsing/expression"
+	"zen/lang/parsing/statement"
+)
+
+// checkVisibility reports access to a private or protected class member from outside the classes allowed to access it,
+// before any statement of the program is executed. It only knows the classes declared at the top level of the program,
+// and the class of the objects created, declared or referred to as this within the program, e.g. A().secret or
+// func read(a: A) { return a.secret }. Any other access is left to the check when it's executed, see checkAccess
+func checkVisibility(program *ast.ProgramNode) error {
+	checker := &visibilityChecker{classes: make(map[string]*statement.ClassDeclaration)}
+	checker.begin()
+	for _, stmt := range program.Statements {
+		if class, ok := stmt.(*statement.ClassDeclaration); ok {
+			if _, declared := checker.classes[class.Name]; !declared {
+				checker.classes[class.Name] = class
+				checker.define(class.Name, binding{class: class, isClass: true})
+			}
+		}
+	}
+	return checker.statements(program.Statements)
+}
+
+// binding is what the visibility check knows about a name: the class it declares, the class of the object it holds, or nothing
+type binding struct {
+	class *statement.ClassDeclaration
+	// isClass is true for the name of a class, rather than an object of it
+	isClass bool
+	// exact is true if the object is known to be of the class itself, not a subclass, which may widen protected methods
+	exact bool
+}
+
+// visibilityChecker walks a program, keeping track of the class whose methods it is in and the names in scope
+type visibilityChecker struct {
+	classes map[string]*statement.ClassDeclaration
+	scopes  []map[string]binding
+	// class whose methods and fields are being checked, nil outside of a class
+	class *statement.ClassDeclaration
+}
+
+func (c *visibilityChecker) begin() { c.scopes = append(c.scopes, make(map[string]binding)) }
+func (c *visibilityChecker) end()   { c.scopes = c.scopes[:len(c.scopes)-1] }
+
+// define binds a name in the innermost scope, shadowing what outer scopes bind it to
+func (c *visibilityChecker) define(name string, b binding) {
+	c.scopes[len(c.scopes)-1][name] = b
+}
+
+func (c *visibilityChecker) lookup(name string) binding {
+	for idx := len(c.scopes) - 1; idx >= 0; idx-- {
+		if b, ok := c.scopes[idx][name]; ok {
+			return b
+		}
+	}
+	return binding{}
+}
+
+// block checks statements in a new scope
+func (c *visibilityChecker) block(stmts []ast.Statement) error {
+	c.begin()
+	defer c.end()
+	return c.statements(stmts)
+}
+
+func (c *visibilityChecker) statements(stmts []ast.Statement) error {
+	for _, stmt := range stmts {
+		if err := c.statement(stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *visibilityChecker) statement(stmt ast.Statement) error {
+	switch s := stmt.(type) {
+	case *statement.VarDeclarationNode:
+		if err := c.expression(s.Initializer); err != nil {
+			return err
+		}
+		c.define(s.Name, c.declared(s.Type, s.Initializer, s.IsConstant))
+	case *statement.DestructuringDeclaration:
+		if err := c.expression(s.Initializer); err != nil {
+			return err
+		}
+		for _, name := range s.Names {
+			c.define(name, binding{})
+		}
+	case *statement.ExpressionStatement:
+		return c.expression(s.Expression)
+	case *statement.ReturnStatmenet:
+		return c.expression(s.Expression)
+	case *statement.ThrowStatement:
+		return c.expression(s.Expression)
+	case *statement.DeferStatement:
+		return c.expression(s.Call)
+	case *statement.IfStatement:
+		if err := c.expression(s.PrimaryCondition); err != nil {
+			return err
+		}
+		if err := c.block(s.PrimaryBlock); err != nil {
+			return err
+		}
+		for _, elseIf := range s.ElseIfBlocks {
+			if err := c.expression(elseIf.Condition); err != nil {
+				return err
+			}
+			if err := c.block(elseIf.Body); err != nil {
+				return err
+			}
+		}
+		return c.block(s.ElseBlock)
+	case *statement.WhileStatement:
+		if err := c.expression(s.Condition); err != nil {
+			return err
+		}
+		return c.block(s.Body)
+	case *statement.ForStatement:
+		c.begin()
+		defer c.end()
+		if s.Init != nil {
+			if err := c.statement(s.Init); err != nil {
+				return err
+			}
+		}
+		if err := c.expression(s.Condition); err != nil {
+			return err
+		}
+		if s.Increment != nil {
+			if err := c.statement(s.Increment); err != nil {
+				return err
+			}
+		}
+		return c.block(s.Body)
+	case *statement.ForInStatement:
+		if err := c.expression(s.Container); err != nil {
+			return err
+		}
+		c.begin()
+		defer c.end()
+		c.define(s.Key, binding{})
+		c.define(s.Value, binding{})
+		if err := c.expression(s.Condition); err != nil {
+			return err
+		}
+		return c.block(s.Body)
+	case *statement.WhenStatement:
+		if err := c.expression(s.Subject); err != nil {
+			return err
+		}
+		for _, arm := range s.Arms {
+			for _, pattern := range arm.Patterns {
+				if err := c.expression(pattern.Value); err != nil {
+					return err
+				}
+			}
+			if err := c.block(arm.Body); err != nil {
+				return err
+			}
+		}
+		return c.block(s.Else)
+	case *statement.TryStatement:
+		if err := c.block(s.Body); err != nil {
+			return err
+		}
+		for _, catch := range s.Catches {
+			c.begin()
+			c.define(catch.Name, binding{})
+			err := c.statements(catch.Body)
+			c.end()
+			if err != nil {
+				return err
+			}
+		}
+		return c.block(s.Finally)
+	case *statement.SelectStatement:
+		for _, arm := range s.Arms {
+			if err := c.expression(arm.Channel); err != nil {
+				return err
+			}
+			if err := c.expression(arm.Value); err != nil {
+				return err
+			}
+			c.begin()
+			c.define(arm.Alias, binding{})
+			err := c.statements(arm.Body)
+			c.end()
+			if err != nil {
+				return err
+			}
+		}
+		return c.block(s.Else)
+	case *statement.FuncDeclaration:
+		c.define(s.Name, binding{})
+		return c.function(s.Parameters, s.Body)
+	case *statement.ClassDeclaration:
+		if c.classes[s.Name] != s {
+			c.define(s.Name, binding{})
+		}
+		return c.classDeclaration(s)
+	case *statement.TraitDeclaration:
+		c.define(s.Name, binding{})
+	case *statement.InterfaceDeclaration:
+		c.define(s.Name, binding{})
+	case *statement.ImportStatement:
+		if s.Alias != "" {
+			c.define(s.Alias, binding{})
+		} else if len(s.Path) > 0 {
+			c.define(s.Path[len(s.Path)-1], binding{})
+		}
+		for _, symbol := range s.Symbols {
+			if symbol.Alias != "" {
+				c.define(symbol.Alias, binding{})
+			} else {
+				c.define(symbol.Name, binding{})
+			}
+		}
+	}
+	return nil
+}
+
+// classDeclaration checks the field initializers and methods of a class, within which its own members are accessible.
+// Classes declared below the top level aren't known to the check, so their members are only checked when they are accessed
+func (c *visibilityChecker) classDeclaration(s *statement.ClassDeclaration) error {
+	outer := c.class
+	c.class = s
+	if c.classes[s.Name] != s {
+		c.class = nil
+	}
+	defer func() { c.class = outer }()
+
+	for _, field := range s.Fields {
+		if err := c.expression(field.Initializer); err != nil {
+			return err
+		}
+	}
+	for _, method := range s.Methods {
+		if err := c.function(method.Parameters, method.Body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// function checks the body of a function, method or lambda in a new scope holding its parameters
+func (c *visibilityChecker) function(params []expression.FuncParameterExpression, body []ast.Statement) error {
+	c.begin()
+	defer c.end()
+	for _, param := range params {
+		if err := c.expression(param.DefaultValue); err != nil {
+			return err
+		}
+		c.define(param.Name, c.declared(param.Type, nil, false))
+	}
+	return c.statements(body)
+}
+
+// declared returns the class of the object a variable or parameter holds, if it's declared with the type of a known class,
+// or is a constant initialized with a new object of one
+func (c *visibilityChecker) declared(typ ast.Expression, initializer ast.Expression, constant bool) binding {
+	if basic, ok := typ.(*expression.BasicType); ok {
+		if b := c.lookup(basic.Name); b.isClass {
+			return binding{class: b.class}
+		}
+		return binding{}
+	}
+	if typ == nil && constant {
+		if class := c.created(initializer); class != nil {
+			return binding{class: class, exact: true}
+		}
+	}
+	return binding{}
+}
+
+// created returns the known class an expression creates a new object of, e.g. A() or new A()
+func (c *visibilityChecker) created(expr ast.Expression) *statement.ClassDeclaration {
+	var callee ast.Expression
+	switch e := expr.(type) {
+	case *expression.CallExpression:
+		callee = e.Callee
+	case *expression.NewExpression:
+		callee = e.Class
+	}
+	if identifier, ok := callee.(*expression.IdentifierExpression); ok {
+		if b := c.lookup(identifier.Name); b.isClass {
+			return b.class
+		}
+	}
+	return nil
+}
+
+// receiver returns what is known about the class of the object a member is accessed on
+func (c *visibilityChecker) receiver(expr ast.Expression) binding {
+	if identifier, ok := expr.(*expression.IdentifierExpression); ok {
+		if identifier.Name == "this" {
+			return binding{class: c.class, exact: true}
+		}
+		if b := c.lookup(identifier.Name); !b.isClass {
+			return b
+		}
+		return binding{}
+	}
+	if class := c.created(expr); class != nil {
+		return binding{class: class, exact: true}
+	}
+	return binding{}
+}
+
+func (c *visibilityChecker) expressions(exprs []ast.Expression) error {
+	for _, expr := range exprs {
+		if err := c.expression(expr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *visibilityChecker) expression(expr ast.Expression) error {
+	switch e := expr.(type) {
+	case *expression.MemberAccessExpression:
+		if err := c.expression(e.Object); err != nil {
+			return err
+		}
+		return c.memberAccess(e)
+	case *expression.BinaryExpression:
+		if err := c.expression(e.Left); err != nil {
+			return err
+		}
+		return c.expression(e.Right)
+	case *expression.UnaryExpression:
+		return c.expression(e.Expression)
+	case *expression.PostfixExpression:
+		return c.expression(e.Operand)
+	case *expression.CallExpression:
+		if err := c.expression(e.Callee); err != nil {
+			return err
+		}
+		return c.expressions(e.Arguments)
+	case *expression.NewExpression:
+		if err := c.expression(e.Class); err != nil {
+			return err
+		}
+		return c.expressions(e.Arguments)
+	case *expression.SpawnExpression:
+		return c.expression(e.Call)
+	case *expression.AwaitExpression:
+		return c.expression(e.Expression)
+	case *expression.ArrayLiteralExpression:
+		return c.expressions(e.Elements)
+	case *expression.TupleExpression:
+		return c.expressions(e.Elements)
+	case *expression.MapLiteralExpression:
+		for _, entry := range e.Entries {
+			if err := c.expression(entry.Key); err != nil {
+				return err
+			}
+			if err := c.expression(entry.Value); err != nil {
+				return err
+			}
+		}
+	case *expression.ArrayAccessExpression:
+		if err := c.expression(e.Array); err != nil {
+			return err
+		}
+		return c.expression(e.Index)
+	case *expression.MapAccessExpression:
+		if err := c.expression(e.Map); err != nil {
+			return err
+		}
+		return c.expression(e.Key)
+	case *expression.RangeExpression:
+		if err := c.expression(e.Start); err != nil {
+			return err
+		}
+		return c.expression(e.End)
+	case *expression.WhereExpression:
+		if err := c.expression(e.Collection); err != nil {
+			return err
+		}
+		c.begin()
+		defer c.end()
+		for _, name := range []string{"value", "index", "key"} {
+			c.define(name, binding{})
+		}
+		return c.expression(e.Condition)
+	case *expression.IsTypeExpression:
+		return c.expression(e.Value)
+	case *expression.HasExpression:
+		return c.expression(e.Value)
+	case *expression.LambdaExpression:
+		return c.function(e.Parameters, e.Body)
+	}
+	return nil
+}
+
+// memberAccess reports the access to a member of an object of a known class if it isn't accessible from the class being checked,
+// with the same message as checkAccess
+func (c *visibilityChecker) memberAccess(expr *expression.MemberAccessExpression) error {
+	receiver := c.receiver(expr.Object)
+	if receiver.class == nil {
+		return nil
+	}
+	visibility, owner, ok := c.memberVisibility(receiver.class, expr.Property)
+	if !ok || visibility == ast.Public || c.canAccess(visibility, owner) {
+		return nil
+	}
+	// Subclasses may widen a protected method, so it's only known to be protected for an object of the class itself
+	if visibility == ast.Protected && !receiver.exact {
+		return nil
+	}
+	return &RuntimeError{
+		Message:  fmt.Sprintf("Cannot access %s member '%s' of class '%s'", visibility, expr.Property, owner.Name),
+		Location: expr.GetLocation(),
+	}
+}
+
+// memberVisibility returns the visibility of a member of a class and the class declaring it, looking up fields before methods
+// like types.Class.MemberVisibility. It's unknown for classes extending or using classes and traits the check doesn't know
+func (c *visibilityChecker) memberVisibility(class *statement.ClassDeclaration, name string) (ast.Visibility, *statement.ClassDeclaration, bool) {
+	chain, ok := c.superclasses(class)
+	if !ok {
+		return ast.Public, nil, false
+	}
+	for _, declaring := range chain {
+		for _, field := range declaring.Fields {
+			if field.Name == name {
+				return field.Visibility, declaring, true
+			}
+		}
+	}
+	for _, declaring := range chain {
+		for _, method := range declaring.Methods {
+			if method.Name == name && name != "init" {
+				return method.Visibility, declaring, true
+			}
+		}
+	}
+	return ast.Public, nil, false
+}
+
+// superclasses returns a class followed by the classes it extends, and false if any of them isn't known to the check
+// or gets members from elsewhere, through traits or included files
+func (c *visibilityChecker) superclasses(class *statement.ClassDeclaration) ([]*statement.ClassDeclaration, bool) {
+	var chain []*statement.ClassDeclaration
+	for class != nil {
+		if len(class.Uses) > 0 || len(class.Includes) > 0 || len(chain) > len(c.classes) {
+			return nil, false
+		}
+		chain = append(chain, class)
+		if class.Superclass == nil {
+			break
+		}
+
+		var name string
+		switch superclass := class.Superclass.(type) {
+		case *expression.BasicType:
+			name = superclass.Name
+		case *expression.ParametricType:
+			name = superclass.BaseType
+		}
+		if class = c.classes[name]; class == nil {
+			return nil, false
+		}
+	}
+	return chain, true
+}
+
+// canAccess returns true if a member of the given visibility declared by owner can be accessed from the class being checked,
+// like types.CanAccess
+func (c *visibilityChecker) canAccess(visibility ast.Visibility, owner *statement.ClassDeclaration) bool {
+	if c.class == nil {
+		return false
+	}
+	if visibility == ast.Private {
+		return c.class == owner
+	}
+	return c.extends(c.class, owner) || c.extends(owner, c.class)
+}
+
+// extends returns true if a class is other or extends it. Classes extending one the check doesn't know may, as far as it knows
+func (c *visibilityChecker) extends(class, other *statement.ClassDeclaration) bool {
+	chain, ok := c.superclasses(class)
+	if !ok {
+		return true
+	}
+	for _, superclass := range chain {
+		if superclass == other {
+			return true
+		}
+	}
+	return false
+}
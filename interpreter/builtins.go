@@ -0,0 +1,125 @@
+package interpreter
+
+import (
+	"zen/builtins/global"
+	"zen/lang/common"
+	"zen/lang/lexing"
+	"zen/lang/parsing"
+	"zen/lang/parsing/statement"
+	"zen/runtime/errors"
+	"zen/runtime/types"
+)
+
+// builtinInterfaces declares the interfaces of the bracket protocol, implemented by classes whose objects
+// are read with items[key] and assigned with items[key] = value, see types.BracketGetMethod and types.BracketSetMethod
+const builtinInterfaces = `
+interface BracketGet<K, V> {
+    protected func _bracketGet(key: K): V
+}
+
+interface BracketSet<K, V> {
+    protected func _bracketSet(key: K, value: V)
+}
+`
+
+// registerBuiltins defines the built-in functions, exception classes and interfaces in the global scope
+func (i *Interpreter) registerBuiltins() {
+	classes, err := errors.DeclareExceptionClasses(i.env.GlobalScope())
+	if err != nil {
+		panic("Failed to register exception classes: " + err.Error())
+	}
+	i.exceptionClasses = classes
+
+	if err := i.declareBuiltinInterfaces(); err != nil {
+		panic("Failed to register built-in interfaces: " + err.Error())
+	}
+
+	i.defineBuiltin(types.NewBuiltinFunction(
+		"print",
+		[]*types.FunctionParameterHint{
+			types.NewFunctionParameterHint("str", types.TypeAny, false),
+		},
+		nil,
+		false,
+		global.Print,
+	))
+
+	i.defineBuiltin(types.NewBuiltinFunction(
+		"include",
+		[]*types.FunctionParameterHint{
+			types.NewFunctionParameterHint("path", types.TypeString, false),
+		},
+		nil,
+		false,
+		i.includeFile,
+	))
+
+	i.defineBuiltin(types.NewBuiltinFunction(
+		"sleep",
+		[]*types.FunctionParameterHint{
+			types.NewFunctionParameterHint("ms", types.TypeInt, false),
+		},
+		nil,
+		true,
+		i.sleep,
+	))
+
+	i.defineBuiltin(types.NewBuiltinFunction(
+		"setTimeout",
+		[]*types.FunctionParameterHint{
+			types.NewFunctionParameterHint("callback", types.TypeAny, false),
+			types.NewFunctionParameterHint("ms", types.TypeInt, false),
+		},
+		nil,
+		false,
+		i.setTimeout,
+	))
+
+	i.defineBuiltin(types.NewBuiltinFunction(
+		"setInterval",
+		[]*types.FunctionParameterHint{
+			types.NewFunctionParameterHint("callback", types.TypeAny, false),
+			types.NewFunctionParameterHint("ms", types.TypeInt, false),
+		},
+		nil,
+		false,
+		i.setInterval,
+	))
+
+	i.defineBuiltin(types.NewBuiltinFunction(
+		"clearTimer",
+		[]*types.FunctionParameterHint{
+			types.NewFunctionParameterHint("timer", types.TypeTimer, false),
+		},
+		nil,
+		false,
+		i.clearTimer,
+	))
+}
+
+// defineBuiltin defines a built-in function as a constant in the global scope
+func (i *Interpreter) defineBuiltin(fn *types.BuiltinFunction) {
+	if err := i.env.GlobalScope().DefineConst(fn.Name, fn); err != nil {
+		panic("Failed to register builtin '" + fn.Name + "': " + err.Error())
+	}
+}
+
+// declareBuiltinInterfaces parses builtinInterfaces and declares them in the current scope, which is the global scope
+// while the built-ins are registered
+func (i *Interpreter) declareBuiltinInterfaces() error {
+	tokens, err := lexing.NewLexer(common.NewInlineSourceCode(builtinInterfaces)).Scan()
+	if err != nil {
+		return err
+	}
+	program, syntaxErrors := parsing.NewParser(tokens, false).Parse()
+	if len(syntaxErrors) > 0 {
+		return syntaxErrors[0]
+	}
+
+	for _, stmt := range program.Statements {
+		if err := i.executeInterfaceDeclaration(stmt.(*statement.InterfaceDeclaration)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
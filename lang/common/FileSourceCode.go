@@ -12,3 +12,12 @@ func NewFileSourceCode(path string, code string) *FileSourceCode {
 		Path:               path,
 	}
 }
+
+// GetLocation returns a SourceLocation in the file at a given line and column
+func (src *FileSourceCode) GetLocation(line int, column int) *SourceLocation {
+	return &SourceLocation{
+		Source: src,
+		Line:   line,
+		Column: column,
+	}
+}
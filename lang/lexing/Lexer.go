@@ -58,7 +58,7 @@ func (l *Lexer) Scan() ([]Token, error) {
 			l.ConsumeAllExcept("\n")
 		case string(ch) == "\"":
 			l.tokens = append(l.tokens, l.scanString())
-		case unicode.IsLetter(ch):
+		case unicode.IsLetter(ch) || ch == '_':
 			l.tokens = append(l.tokens, l.scanIdentifierOrKeyword())
 		case unicode.IsDigit(ch):
 			l.tokens = append(l.tokens, l.scanNumber())
@@ -66,6 +66,8 @@ func (l *Lexer) Scan() ([]Token, error) {
 			l.scanSequence("++", INCREMENT)
 		case l.isSequence("--"):
 			l.scanSequence("--", DECREMENT)
+		case l.isSequence("->"):
+			l.scanSequence("->", ARROW)
 		case l.isSequence("+="):
 			l.scanSequence("+=", PLUS_ASSIGN)
 		case l.isSequence("-="):
@@ -112,12 +114,16 @@ func (l *Lexer) Scan() ([]Token, error) {
 			l.ConsumeToken(RIGHT_BRACKET)
 		case string(ch) == ":":
 			l.ConsumeToken(COLON)
+		case l.isSequence(".."):
+			l.scanSequence("..", DOT_DOT)
 		case string(ch) == ".":
 			l.ConsumeToken(DOT)
 		case string(ch) == "=":
 			l.ConsumeToken(ASSIGN)
 		case string(ch) == "?":
 			l.ConsumeToken(QMARK)
+		case string(ch) == "|":
+			l.ConsumeToken(PIPE)
 		case unicode.IsSpace(ch):
 			l.IgnoreWhitespace()
 		default:
@@ -198,7 +204,8 @@ func (l *Lexer) scanNumber() Token {
 	for {
 		if unicode.IsDigit(l.Peek()) {
 			l.Consume()
-		} else if l.Previous() != '.' && l.Peek() == '.' {
+		} else if l.Peek() == '.' && unicode.IsDigit(l.Next()) && !strings.Contains(l.SourceCode.GetText()[start:l.Index], ".") {
+			// A dot is only part of the number if a digit follows, so 3..5 is a range rather than the float 3.
 			l.Consume()
 		} else {
 			break
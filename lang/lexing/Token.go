@@ -16,10 +16,13 @@ const (
 	STRING // "hello, world"
 
 	DOT
+	DOT_DOT
 	COMMA
 	COLON
 	SEMICOLON
 	QMARK
+	ARROW
+	PIPE
 
 	LEFT_PAREN
 	RIGHT_PAREN
@@ -64,10 +67,13 @@ var tokenTypeNames = map[TokenType]string{
 	STRING: "String",
 
 	DOT:       "Dot",
+	DOT_DOT:   "DotDot",
 	COMMA:     "Comma",
 	COLON:     "Colon",
 	SEMICOLON: "Semicolon",
 	QMARK:     "QuestionMark",
+	ARROW:     "Arrow",
+	PIPE:      "Pipe",
 
 	LEFT_PAREN:    "LeftParen",
 	RIGHT_PAREN:   "RightParen",
@@ -102,6 +108,7 @@ var tokenTypeNames = map[TokenType]string{
 var keywords = []string{
 	"import",
 	"from",
+	"as",
 	"package",
 
 	"var",
@@ -140,6 +147,8 @@ var keywords = []string{
 	"defer",
 	"async",
 	"await",
+	"spawn",
+	"select",
 
 	"break",
 	"continue",
@@ -149,15 +158,20 @@ var keywords = []string{
 	"class",
 	"interface",
 	"implements",
+	"trait",
+	"use",
 	"extends",
 	"new",
 	"this",
 	"super",
 	"pub",
+	"private",
+	"protected",
 
 	"and",
 	"or",
 	"not",
+	"is",
 }
 
 type Token struct {
@@ -13,6 +13,8 @@ type Parser struct {
 	errors             []*common.SyntaxError
 	mapAccessEnabled   bool // Flag to control map access parsing
 	arrayAccessEnabled bool // Flag to control array access parsing
+	whenSubjectEnabled bool // Flag to allow leading-dot predicates (e.g. `.length > 5`) in when arms
+	whenSubjectUsed    bool // Set when a leading-dot predicate refers to the when subject
 }
 
 // NewParser creates a new Parser instance
@@ -37,6 +39,27 @@ func (p *Parser) EnableMapAccess() {
 	p.mapAccessEnabled = true
 }
 
+// parseBlockExpression parses an expression that is directly followed by a block, such as an if condition.
+// The block's '{' may be mistaken for map access (e.g. `if not loop.last {`), so if no '{' follows
+// the expression, it is parsed again with map access disabled
+func (p *Parser) parseBlockExpression() ast.Expression {
+	current := p.current
+	errorCount := len(p.errors)
+
+	expr := p.parseExpression()
+	if expr == nil || p.check(lexing.LEFT_BRACE) {
+		return expr
+	}
+
+	// Restore state and try again with map access disabled
+	p.current = current
+	p.errors = p.errors[:errorCount]
+	p.DisableMapAccess()
+	expr = p.parseExpression()
+	p.EnableMapAccess()
+	return expr
+}
+
 // DisableArrayAccess temporarily disables array access parsing
 func (p *Parser) DisableArrayAccess() {
 	p.arrayAccessEnabled = false
@@ -67,7 +90,14 @@ func (p *Parser) Parse() (*ast.ProgramNode, []*common.SyntaxError) {
 			break
 		}
 
-		stmt := p.parseStatement()
+		var stmt ast.Statement
+		if p.checkVisibility() {
+			stmt = p.parseTopLevelDeclaration()
+		} else if p.checkModuleStatement() {
+			stmt = p.parseModuleStatement()
+		} else {
+			stmt = p.parseStatement()
+		}
 		if stmt != nil {
 			statements = append(statements, stmt)
 		} else if len(p.errors) > 0 && !p.stopAtFirstError {
@@ -122,6 +152,14 @@ func (p *Parser) peek() lexing.Token {
 	return p.tokens[p.current]
 }
 
+// peekNext returns the token after the current token
+func (p *Parser) peekNext() lexing.Token {
+	if p.current+1 >= len(p.tokens) {
+		return lexing.Token{Type: lexing.EOF}
+	}
+	return p.tokens[p.current+1]
+}
+
 // previous returns the previous token
 func (p *Parser) previous() lexing.Token {
 	return p.tokens[p.current-1]
@@ -1,33 +1,17 @@
 package parsing
 
 import (
-	"strconv"
 	"zen/lang/lexing"
 	"zen/lang/parsing/ast"
 	"zen/lang/parsing/expression"
 )
 
-// parseArrayAccessExpression parses array access expressions like array[idx] or array[2]
+// parseArrayAccessExpression parses array access expressions like array[idx], array[2] or array[num - 4]
 func (p *Parser) parseArrayAccessExpression(array ast.Expression) ast.Expression {
 	// We've already consumed the '['
-	var index ast.Expression
-
-	// Parse index - only allow literals and identifiers for now
-	token := p.peek()
-	switch token.Type {
-	case lexing.INT:
-		p.advance()
-		value, err := strconv.ParseInt(token.Literal, 10, 64)
-		if err != nil {
-			p.errorAtToken(token, "Invalid integer literal")
-			return nil
-		}
-		index = expression.NewLiteralExpression(value, token.Location)
-	case lexing.IDENTIFIER:
-		p.advance()
-		index = expression.NewIdentifierExpression(token.Literal, token.Location)
-	default:
-		p.error("Expected integer or identifier for array index")
+	index := p.parseExpression()
+	if index == nil {
+		p.error("Expected expression for array index")
 		return nil
 	}
 
@@ -0,0 +1,268 @@
+package parsing
+
+import (
+	"zen/lang/common"
+	"zen/lang/lexing"
+	"zen/lang/parsing/ast"
+	"zen/lang/parsing/expression"
+	"zen/lang/parsing/statement"
+)
+
+// parseClassDeclaration parses a class declaration
+// Syntax: class Name[<T, ...>] [extends Superclass] [implements Interface, ...] { members }
+func (p *Parser) parseClassDeclaration() ast.Statement {
+	startToken := p.previous() // The 'class' token
+
+	name := p.consume(lexing.IDENTIFIER, "Expected class name")
+	if len(p.errors) > 0 {
+		return nil
+	}
+
+	var typeParams []*expression.TypeParameter
+	if p.match(lexing.LESS) {
+		typeParams = p.parseTypeParameterDeclarations()
+		if typeParams == nil {
+			return nil
+		}
+	}
+
+	var superclass ast.Expression
+	if p.matchKeyword("extends") {
+		superclass = p.parseType()
+		if superclass == nil {
+			return nil
+		}
+	}
+
+	var interfaces []ast.Expression
+	if p.matchKeyword("implements") {
+		for {
+			iface := p.parseType()
+			if iface == nil {
+				return nil
+			}
+			interfaces = append(interfaces, iface)
+			if !p.match(lexing.COMMA) {
+				break
+			}
+		}
+	}
+
+	if !p.match(lexing.LEFT_BRACE) {
+		p.error("Expected '{' after class name")
+		return nil
+	}
+
+	class := statement.NewClassDeclaration(name.Literal, superclass, interfaces, nil, make([]*statement.VarDeclarationNode, 0), make([]*statement.FuncDeclaration, 0), startToken.Location)
+	class.TypeParameters = typeParams
+
+	if !p.parseClassMembers(class) {
+		return nil
+	}
+
+	if p.isAtEnd() || p.check(lexing.EOF) {
+		p.error("Unterminated class body - expected '}'")
+		return nil
+	}
+	if !p.match(lexing.RIGHT_BRACE) {
+		p.error("Expected '}' after class body")
+		return nil
+	}
+
+	return class
+}
+
+// ParseClassMembers parses a file included in a class body, which declares members of the class, see statement.ClassInclude.
+// The members are returned as an anonymous class declaration
+func (p *Parser) ParseClassMembers() (*statement.ClassDeclaration, []*common.SyntaxError) {
+	class := statement.NewClassDeclaration("", nil, nil, nil, make([]*statement.VarDeclarationNode, 0), make([]*statement.FuncDeclaration, 0), p.peek().Location)
+
+	if p.parseClassMembers(class) && !p.isAtEnd() && !p.check(lexing.EOF) {
+		p.errorAtToken(p.peek(), "Expected field or method declaration")
+	}
+
+	if len(p.errors) > 0 {
+		return class, p.errors
+	}
+	return class, nil
+}
+
+// parseClassMembers parses the trait uses, fields, methods and includes of a class body into the given declaration,
+// up to the closing '}' or the end of the file.
+// Returns false if a member is invalid
+func (p *Parser) parseClassMembers(class *statement.ClassDeclaration) bool {
+	for !p.check(lexing.RIGHT_BRACE) && !p.isAtEnd() && !p.check(lexing.EOF) {
+		if p.matchKeyword("use") {
+			use := p.parseTraitUse()
+			if use == nil {
+				return false
+			}
+			class.Uses = append(class.Uses, use)
+			continue
+		}
+
+		if p.checkClassInclude() {
+			include := p.parseClassInclude()
+			if include == nil {
+				return false
+			}
+			class.Includes = append(class.Includes, include)
+			continue
+		}
+
+		field, method := p.parseClassMember()
+		if field == nil && method == nil {
+			return false
+		}
+		if field != nil {
+			class.Fields = append(class.Fields, field)
+		} else {
+			class.Methods = append(class.Methods, method)
+		}
+	}
+	return true
+}
+
+// checkClassInclude returns true if the current token starts a call to include in a class body, e.g. include("_methods.zen")
+func (p *Parser) checkClassInclude() bool {
+	return p.check(lexing.IDENTIFIER) && p.peek().Literal == "include" && p.peekNext().Type == lexing.LEFT_PAREN
+}
+
+// parseClassInclude parses a call to include in a class body
+// Syntax: include("path")
+func (p *Parser) parseClassInclude() *statement.ClassInclude {
+	startToken := p.advance() // The 'include' token
+	p.advance()               // The '(' token
+
+	path := p.consume(lexing.STRING, "Expected the path of the included file as a string")
+	if len(p.errors) > 0 {
+		return nil
+	}
+	p.consume(lexing.RIGHT_PAREN, "Expected ')' after the path of the included file")
+	if len(p.errors) > 0 {
+		return nil
+	}
+
+	return statement.NewClassInclude(path.Literal, startToken.Location)
+}
+
+// parseClassMember parses a single field or method of a class, preceded by an optional visibility modifier
+// Returns nil for both if the member is invalid
+func (p *Parser) parseClassMember() (*statement.VarDeclarationNode, *statement.FuncDeclaration) {
+	visibility := p.parseVisibility()
+	field, method := p.parseClassMemberDeclaration()
+	if field != nil {
+		field.Visibility = visibility
+	}
+	if method != nil {
+		method.Visibility = visibility
+	}
+	return field, method
+}
+
+// parseClassMemberDeclaration parses a field or method after its visibility modifier.
+// Fields are declared with or without 'var'/'const' (name: type = default),
+// methods with or without 'func' (name(params): type { body })
+// Returns nil for both if the member is invalid
+func (p *Parser) parseClassMemberDeclaration() (*statement.VarDeclarationNode, *statement.FuncDeclaration) {
+	// var name: type = default
+	if p.matchKeyword("var", "const") {
+		startToken := p.previous()
+		name := p.consume(lexing.IDENTIFIER, "Expected field name")
+		if len(p.errors) > 0 {
+			return nil, nil
+		}
+		return p.parseVarDeclarationRest(name, startToken.Literal == "const", startToken), nil
+	}
+
+	// async func name(params) { body }
+	if p.matchKeyword("async") {
+		startToken := p.previous()
+		p.matchKeyword("func")
+		name := p.consume(lexing.IDENTIFIER, "Expected method name")
+		if len(p.errors) > 0 {
+			return nil, nil
+		}
+		return nil, p.parseFuncDeclarationRest(name, true, startToken)
+	}
+
+	// func name(params) { body }
+	if p.matchKeyword("func") {
+		startToken := p.previous()
+		name := p.consume(lexing.IDENTIFIER, "Expected method name")
+		if len(p.errors) > 0 {
+			return nil, nil
+		}
+		return nil, p.parseFuncDeclarationRest(name, false, startToken)
+	}
+
+	if !p.check(lexing.IDENTIFIER) {
+		p.errorAtToken(p.peek(), "Expected field or method declaration")
+		return nil, nil
+	}
+	name := p.advance()
+
+	// name(params) { body } or name<T>(params) { body }
+	if p.check(lexing.LEFT_PAREN) || p.check(lexing.LESS) {
+		return nil, p.parseFuncDeclarationRest(name, false, name)
+	}
+
+	// name: type = default
+	if p.check(lexing.COLON) {
+		return p.parseVarDeclarationRest(name, false, name), nil
+	}
+
+	p.errorAtToken(p.peek(), "Expected ':' or '(' after member name")
+	return nil, nil
+}
+
+// parseTraitUse parses the use of a trait in a class body, after the 'use' keyword
+// Syntax: use Name[(args)] [{ member as alias ... }]
+func (p *Parser) parseTraitUse() *statement.TraitUse {
+	startToken := p.previous() // The 'use' token
+
+	name := p.consume(lexing.IDENTIFIER, "Expected trait name after 'use'")
+	if len(p.errors) > 0 {
+		return nil
+	}
+	trait := expression.NewIdentifierExpression(name.Literal, name.Location)
+
+	args := make([]ast.Expression, 0)
+	if p.match(lexing.LEFT_PAREN) {
+		call, ok := p.finishCall(trait).(*expression.CallExpression)
+		if !ok {
+			return nil
+		}
+		args = call.Arguments
+	}
+
+	aliases := make([]*statement.TraitAlias, 0)
+	if p.match(lexing.LEFT_BRACE) {
+		for !p.match(lexing.RIGHT_BRACE) {
+			if p.isAtEnd() || p.check(lexing.EOF) {
+				p.error("Unterminated trait aliases - expected '}'")
+				return nil
+			}
+
+			member := p.consume(lexing.IDENTIFIER, "Expected trait member name")
+			if len(p.errors) > 0 {
+				return nil
+			}
+			if !p.matchKeyword("as") {
+				p.error("Expected 'as' after trait member name")
+				return nil
+			}
+			alias := p.consume(lexing.IDENTIFIER, "Expected alias after 'as'")
+			if len(p.errors) > 0 {
+				return nil
+			}
+			aliases = append(aliases, &statement.TraitAlias{
+				Member:   member.Literal,
+				Alias:    alias.Literal,
+				Location: member.Location,
+			})
+		}
+	}
+
+	return statement.NewTraitUse(trait, args, aliases, startToken.Location)
+}
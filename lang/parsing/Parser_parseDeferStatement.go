@@ -0,0 +1,26 @@
+package parsing
+
+import (
+	"zen/lang/parsing/ast"
+	"zen/lang/parsing/expression"
+	"zen/lang/parsing/statement"
+)
+
+// parseDeferStatement parses a call deferred until the enclosing function exits
+// Syntax: defer call
+func (p *Parser) parseDeferStatement() ast.Statement {
+	startToken := p.previous() // The 'defer' token
+
+	exp := p.parseExpression()
+	if exp == nil {
+		p.error("Expected expression after 'defer'")
+		return nil
+	}
+	call, ok := exp.(*expression.CallExpression)
+	if !ok {
+		p.errorAtToken(startToken, "Expected a function call after 'defer'")
+		return nil
+	}
+
+	return statement.NewDeferStatement(call, startToken.Location)
+}
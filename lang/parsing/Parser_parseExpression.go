@@ -14,7 +14,7 @@ func (p *Parser) parseExpression() ast.Expression {
 
 // parseAssignment parses assignment expressions including compound assignments (+=, -=, etc.)
 func (p *Parser) parseAssignment() ast.Expression {
-	expr := p.parseLogicalOr()
+	expr := p.parseWhere()
 
 	if p.match(lexing.ASSIGN, lexing.PLUS_ASSIGN, lexing.MINUS_ASSIGN, lexing.MULTIPLY_ASSIGN, lexing.DIVIDE_ASSIGN) {
 		operator := p.previous()
@@ -52,6 +52,23 @@ func (p *Parser) parseAssignment() ast.Expression {
 	return expr
 }
 
+// parseWhere parses collection filters like people where person{"age"} > 20
+func (p *Parser) parseWhere() ast.Expression {
+	expr := p.parseLogicalOr()
+
+	for expr != nil && p.matchKeyword("where") {
+		operator := p.previous()
+		condition := p.parseLogicalOr()
+		if condition == nil {
+			p.error("Expected condition after 'where'")
+			return nil
+		}
+		expr = expression.NewWhereExpression(expr, condition, operator.Location)
+	}
+
+	return expr
+}
+
 // parseLogicalOr parses logical OR expressions
 func (p *Parser) parseLogicalOr() ast.Expression {
 	expr := p.parseLogicalAnd()
@@ -103,18 +120,70 @@ func (p *Parser) parseEquality() ast.Expression {
 	return expr
 }
 
-// parseComparison parses comparison expressions
+// parseComparison parses comparison expressions, including the type checks 'is' and 'has'
 func (p *Parser) parseComparison() ast.Expression {
+	expr := p.parseRange()
+
+	for expr != nil {
+		if p.match(lexing.LESS, lexing.LESS_EQUALS, lexing.GREATER, lexing.GREATER_EQUALS) {
+			operator := p.previous().Literal
+			right := p.parseRange()
+			if right == nil {
+				p.error("Expected expression after comparison operator")
+				return nil
+			}
+			expr = expression.NewBinaryExpression(expr, operator, right, p.previous().Location)
+		} else if p.matchKeyword("is") {
+			operator := p.previous()
+			typ := p.parseType()
+			if typ == nil {
+				return nil
+			}
+			expr = expression.NewIsTypeExpression(expr, typ, operator.Location)
+		} else if p.check(lexing.IDENTIFIER) && p.peek().Literal == "has" {
+			// 'has' is not a keyword, so it can still name methods such as map.has(key)
+			expr = p.parseHasExpression(expr, p.advance())
+		} else {
+			break
+		}
+	}
+
+	return expr
+}
+
+// parseHasExpression parses the interface and optional member following 'has', e.g. shape has Drawable.draw
+func (p *Parser) parseHasExpression(value ast.Expression, operator lexing.Token) ast.Expression {
+	name := p.consume(lexing.IDENTIFIER, "Expected interface name after 'has'")
+	if len(p.errors) > 0 {
+		return nil
+	}
+
+	member := ""
+	if p.match(lexing.DOT) {
+		property := p.consume(lexing.IDENTIFIER, "Expected member name after '.'")
+		if len(p.errors) > 0 {
+			return nil
+		}
+		member = property.Literal
+	}
+
+	iface := expression.NewIdentifierExpression(name.Literal, name.Location)
+	return expression.NewHasExpression(value, iface, member, operator.Location)
+}
+
+// parseRange parses range expressions like 3..5 or start..start + count
+// Ranges bind looser than arithmetic and don't chain
+func (p *Parser) parseRange() ast.Expression {
 	expr := p.parseAdditive()
 
-	for p.match(lexing.LESS, lexing.LESS_EQUALS, lexing.GREATER, lexing.GREATER_EQUALS) {
-		operator := p.previous().Literal
-		right := p.parseAdditive()
-		if right == nil {
-			p.error("Expected expression after comparison operator")
+	if expr != nil && p.match(lexing.DOT_DOT) {
+		operator := p.previous()
+		end := p.parseAdditive()
+		if end == nil {
+			p.error("Expected expression after '..'")
 			return nil
 		}
-		expr = expression.NewBinaryExpression(expr, operator, right, p.previous().Location)
+		return expression.NewRangeExpression(expr, end, operator.Location)
 	}
 
 	return expr
@@ -181,9 +250,29 @@ func (p *Parser) parseUnary() ast.Expression {
 		return expression.NewUnaryExpression(operator.Literal, expr, operator.Location)
 	}
 
+	if p.matchKeyword("spawn") {
+		return p.parseSpawnExpression()
+	}
+
 	return p.parsePostfix()
 }
 
+// parseSpawnExpression parses the function call following the spawn keyword, e.g. spawn worker(jobs, 5)
+func (p *Parser) parseSpawnExpression() ast.Expression {
+	location := p.previous().Location
+	expr := p.parsePostfix()
+	if expr == nil {
+		return nil
+	}
+
+	call, ok := expr.(*expression.CallExpression)
+	if !ok {
+		p.errorAtToken(p.previous(), "Expected a function call after 'spawn'")
+		return nil
+	}
+	return expression.NewSpawnExpression(call, location)
+}
+
 // parsePostfix parses postfix operators (++, --)
 func (p *Parser) parsePostfix() ast.Expression {
 	expr := p.parseCall()
@@ -220,6 +309,13 @@ func (p *Parser) parseCall() ast.Expression {
 	for {
 		if p.match(lexing.LEFT_PAREN) {
 			expr = p.finishCall(expr)
+		} else if p.check(lexing.LESS) && isValidGenericCallTarget(expr) {
+			// Try to parse a generic call, e.g. first<int>(xs), otherwise it's a comparison
+			if call := p.tryParseGenericCall(expr); call != nil {
+				expr = call
+			} else {
+				break
+			}
 		} else if p.match(lexing.DOT) {
 			// Handle member access (obj.prop)
 			if !p.check(lexing.IDENTIFIER) {
@@ -256,6 +352,11 @@ func (p *Parser) tryParseMapAccess(target ast.Expression) ast.Expression {
 	current := p.current
 	errorCount := len(p.errors)
 
+	// This is only an attempt, so errors must not abort parsing
+	stopAtFirstError := p.stopAtFirstError
+	p.stopAtFirstError = false
+	defer func() { p.stopAtFirstError = stopAtFirstError }()
+
 	// Try to parse map access
 	p.advance()                // consume {
 	key := p.parseExpression() // Allow any expression as key
@@ -277,6 +378,46 @@ func (p *Parser) tryParseMapAccess(target ast.Expression) ast.Expression {
 	return expression.NewMapAccessExpression(target, key, p.previous().Location)
 }
 
+// tryParseGenericCall attempts to parse type arguments followed by a call, returning nil if it fails
+func (p *Parser) tryParseGenericCall(callee ast.Expression) ast.Expression {
+	// Save current state
+	current := p.current
+	errorCount := len(p.errors)
+
+	// This is only an attempt, so errors must not abort parsing
+	stopAtFirstError := p.stopAtFirstError
+	p.stopAtFirstError = false
+
+	p.advance() // consume <
+	typeArgs := p.parseTypeArguments()
+	if typeArgs == nil || !p.match(lexing.LEFT_PAREN) {
+		// Not type arguments, restore state and return nil
+		p.current = current
+		p.errors = p.errors[:errorCount]
+		p.stopAtFirstError = stopAtFirstError
+		return nil
+	}
+	p.stopAtFirstError = stopAtFirstError
+
+	call, ok := p.finishCall(callee).(*expression.CallExpression)
+	if !ok {
+		return nil
+	}
+	call.TypeArguments = typeArgs
+	return call
+}
+
+// isValidGenericCallTarget returns true if the expression can be called with type arguments
+func isValidGenericCallTarget(expr ast.Expression) bool {
+	switch e := expr.(type) {
+	case *expression.IdentifierExpression:
+		return !lexing.IsKeyword(e.Name)
+	case *expression.MemberAccessExpression:
+		return true
+	}
+	return false
+}
+
 // parseMapKey parses a map key (string, number, or identifier)
 func (p *Parser) parseMapKey() ast.Expression {
 	token := p.peek()
@@ -297,8 +438,8 @@ func (p *Parser) parseMapKey() ast.Expression {
 func isValidMapAccessTarget(expr ast.Expression) bool {
 	switch e := expr.(type) {
 	case *expression.IdentifierExpression:
-		// Only allow map access on identifiers that aren't keywords
-		return !lexing.IsKeyword(e.Name)
+		// Only allow map access on identifiers that aren't keywords, except this and super
+		return !lexing.IsKeyword(e.Name) || e.Name == "this" || e.Name == "super"
 	case *expression.MemberAccessExpression,
 		*expression.ArrayAccessExpression,
 		*expression.MapAccessExpression,
@@ -308,6 +449,46 @@ func isValidMapAccessTarget(expr ast.Expression) bool {
 	return false
 }
 
+// parseNewExpression parses the class and optional arguments following 'new', e.g. new Person("john")
+// The class may be accessed through members, e.g. new shapes.Circle()
+func (p *Parser) parseNewExpression(newToken lexing.Token) ast.Expression {
+	name := p.consume(lexing.IDENTIFIER, "Expected class name after 'new'")
+	if len(p.errors) > 0 {
+		return nil
+	}
+
+	var class ast.Expression = expression.NewIdentifierExpression(name.Literal, name.Location)
+	for p.match(lexing.DOT) {
+		property := p.consume(lexing.IDENTIFIER, "Expected property name after '.'")
+		if len(p.errors) > 0 {
+			return nil
+		}
+		class = expression.NewMemberAccessExpression(class, property.Literal, property.Location)
+	}
+
+	// Type arguments of a generic class, e.g. new Box<int>(1)
+	var typeArgs []expression.Parameter
+	if p.match(lexing.LESS) {
+		typeArgs = p.parseTypeArguments()
+		if typeArgs == nil {
+			return nil
+		}
+	}
+
+	args := make([]ast.Expression, 0)
+	if p.match(lexing.LEFT_PAREN) {
+		call, ok := p.finishCall(class).(*expression.CallExpression)
+		if !ok {
+			return nil
+		}
+		args = call.Arguments
+	}
+
+	newExpr := expression.NewNewExpression(class, args, newToken.Location)
+	newExpr.TypeArguments = typeArgs
+	return newExpr
+}
+
 // finishCall handles the parsing of function call arguments after '(' has been matched
 func (p *Parser) finishCall(callee ast.Expression) ast.Expression {
 	args := make([]ast.Expression, 0)
@@ -347,6 +528,9 @@ func (p *Parser) parsePrimary() ast.Expression {
 		return p.parseArrayLiteral()
 
 	case lexing.LEFT_BRACE:
+		if p.isLambdaStart() {
+			return p.parseLambda()
+		}
 		return p.parseMapLiteral()
 
 	case lexing.STRING:
@@ -384,6 +568,21 @@ func (p *Parser) parsePrimary() ast.Expression {
 		} else if token.Literal == "null" {
 			p.advance()
 			return expression.NewLiteralExpression(nil, token.Location)
+		} else if token.Literal == "this" || token.Literal == "super" {
+			// 'this' and 'super' are defined as constants in the scope of every method call
+			p.advance()
+			return expression.NewIdentifierExpression(token.Literal, token.Location)
+		} else if token.Literal == "new" {
+			p.advance()
+			return p.parseNewExpression(token)
+		}
+
+	case lexing.DOT:
+		// A leading dot refers to the subject of a when statement, e.g. `.length > 5`
+		// The dot itself is left for parseCall to turn into member access
+		if p.whenSubjectEnabled {
+			p.whenSubjectUsed = true
+			return expression.NewWhenSubjectExpression(token.Location)
 		}
 
 	case lexing.LEFT_PAREN:
@@ -12,6 +12,7 @@ import (
 //
 //	for key, value in container { body }
 //	for value in container { body }
+//	for value in container where condition { body }
 func (p *Parser) parseForInStatement() ast.Statement {
 	startToken := p.previous() // The 'for' token
 
@@ -48,27 +49,17 @@ func (p *Parser) parseForInStatement() ast.Statement {
 		return nil
 	}
 
-	// Save state before parsing container
-	current := p.current
-	errorCount := len(p.errors)
-
-	// Try parsing container with map access enabled
-	container := p.parseExpression()
+	container := p.parseBlockExpression()
 	if container == nil {
 		p.error("Expected expression after 'in'")
 		return nil
 	}
 
-	// If we got a map access but no LEFT_BRACE follows, try again without map access
-	if _, isMapAccess := container.(*expression.MapAccessExpression); isMapAccess {
-		if !p.check(lexing.LEFT_BRACE) {
-			// Restore state and try again with map access disabled
-			p.current = current
-			p.errors = p.errors[:errorCount]
-			p.DisableMapAccess()
-			container = p.parseExpression()
-			p.EnableMapAccess()
-		}
+	// The where-clause is parsed as part of the container, split it off
+	var condition ast.Expression
+	if where, ok := container.(*expression.WhereExpression); ok {
+		container = where.Collection
+		condition = where.Condition
 	}
 
 	// Parse body
@@ -88,6 +79,7 @@ func (p *Parser) parseForInStatement() ast.Statement {
 		key,
 		value,
 		container,
+		condition,
 		body,
 		startToken.Location,
 	)
@@ -17,6 +17,57 @@ func (p *Parser) parseFuncDeclaration(async bool) ast.Statement {
 		return nil
 	}
 
+	decl := p.parseFuncDeclarationRest(name, async, startToken)
+	if decl == nil {
+		// Avoid returning a typed nil
+		return nil
+	}
+	return decl
+}
+
+// parseFuncDeclarationRest parses the parameters, return type and body following a function's name.
+// It is shared by function declarations and class methods, which don't need the 'func' keyword
+func (p *Parser) parseFuncDeclarationRest(name lexing.Token, async bool, startToken lexing.Token) *statement.FuncDeclaration {
+	decl := p.parseFuncSignature(name, async, startToken)
+	if decl == nil {
+		return nil
+	}
+
+	// Parse function body
+	if !p.match(lexing.LEFT_BRACE) {
+		p.error("Expected '{' after function declaration")
+		return nil
+	}
+
+	// Parse the function body
+	body := p.parseBlock()
+	if body == nil {
+		// Error already reported by parseBlock
+		return nil
+	}
+
+	// Consume the closing brace
+	if !p.match(lexing.RIGHT_BRACE) {
+		p.error("Expected '}' after function body")
+		return nil
+	}
+
+	decl.Body = body
+	return decl
+}
+
+// parseFuncSignature parses the type parameters, parameters and return type following a function's name.
+// The returned declaration has no body, which is how interfaces declare their methods
+func (p *Parser) parseFuncSignature(name lexing.Token, async bool, startToken lexing.Token) *statement.FuncDeclaration {
+	// Parse the type parameters of a generic function, e.g. first<T>
+	var typeParams []*expression.TypeParameter
+	if p.match(lexing.LESS) {
+		typeParams = p.parseTypeParameterDeclarations()
+		if typeParams == nil {
+			return nil
+		}
+	}
+
 	// Parse function parameters
 	if !p.match(lexing.LEFT_PAREN) {
 		p.error("Expected '(' after function name")
@@ -58,42 +109,75 @@ func (p *Parser) parseFuncDeclaration(async bool) ast.Statement {
 	// Parse optional return type (defaults to "void" if not specified)
 	var returnType ast.Expression
 	if p.match(lexing.COLON) {
-		returnType = p.parseType()
-		if returnType == nil {
-			return nil
+		if p.check(lexing.IDENTIFIER) && p.peekNext().Type == lexing.COLON {
+			// Named multiple return values, e.g. statusCode: int, body: string
+			tupleType := p.parseTupleType()
+			if tupleType == nil {
+				return nil
+			}
+			returnType = tupleType
+		} else {
+			returnType = p.parseType()
+			if returnType == nil {
+				return nil
+			}
 		}
 	} else {
 		// Default return type is void
 		returnType = expression.NewBasicType("void", startToken.Location)
 	}
 
-	// Parse function body
-	if !p.match(lexing.LEFT_BRACE) {
-		p.error("Expected '{' after function declaration")
-		return nil
-	}
-
-	// Parse the function body
-	body := p.parseBlock()
-	if body == nil {
-		// Error already reported by parseBlock
-		return nil
-	}
-
-	// Consume the closing brace
-	if !p.match(lexing.RIGHT_BRACE) {
-		p.error("Expected '}' after function body")
-		return nil
-	}
-
-	return statement.NewFuncDeclaration(
+	decl := statement.NewFuncDeclaration(
 		name.Literal,
 		parameters,
 		returnType,
-		body,
+		nil,
 		async,
 		startToken.Location,
 	)
+	decl.TypeParameters = typeParams
+	return decl
+}
+
+// parseTupleType parses the comma separated names and types of the values returned by a function returning multiple values
+func (p *Parser) parseTupleType() *expression.TupleType {
+	location := p.peek().Location
+	elements := make([]*expression.TupleTypeElement, 0)
+	names := make(map[string]bool)
+
+	for {
+		name := p.consume(lexing.IDENTIFIER, "Expected return value name")
+		if len(p.errors) > 0 {
+			return nil
+		}
+		if names[name.Literal] {
+			p.error("Duplicate return value name '" + name.Literal + "'")
+			return nil
+		}
+		names[name.Literal] = true
+
+		p.consume(lexing.COLON, "Expected ':' after return value name")
+		if len(p.errors) > 0 {
+			return nil
+		}
+
+		elementType := p.parseType()
+		if elementType == nil {
+			return nil
+		}
+
+		elements = append(elements, &expression.TupleTypeElement{
+			Name:       name.Literal,
+			Type:       elementType,
+			IsNullable: p.match(lexing.QMARK),
+		})
+
+		if !p.match(lexing.COMMA) {
+			break
+		}
+	}
+
+	return expression.NewTupleType(elements, location)
 }
 
 // parseFuncParameter: Parses a function parameter
@@ -3,7 +3,6 @@ package parsing
 import (
 	"zen/lang/lexing"
 	"zen/lang/parsing/ast"
-	"zen/lang/parsing/expression"
 	"zen/lang/parsing/statement"
 )
 
@@ -11,29 +10,12 @@ import (
 func (p *Parser) parseIfStatement() ast.Statement {
 	startToken := p.previous() // The 'if' token
 
-	// Save state before parsing condition
-	current := p.current
-	errorCount := len(p.errors)
-
-	// Try parsing condition with map access enabled
-	condition := p.parseExpression()
+	condition := p.parseBlockExpression()
 	if condition == nil {
 		p.error("Expected condition after 'if'")
 		return nil
 	}
 
-	// If we got a map access but no LEFT_BRACE follows, try again without map access
-	if _, isMapAccess := condition.(*expression.MapAccessExpression); isMapAccess {
-		if !p.check(lexing.LEFT_BRACE) {
-			// Restore state and try again with map access disabled
-			p.current = current
-			p.errors = p.errors[:errorCount]
-			p.DisableMapAccess()
-			condition = p.parseExpression()
-			p.EnableMapAccess()
-		}
-	}
-
 	if !p.match(lexing.LEFT_BRACE) {
 		p.error("Expected '{' after 'if' condition")
 		return nil
@@ -52,29 +34,12 @@ func (p *Parser) parseIfStatement() ast.Statement {
 	for p.matchKeyword("elif") {
 		elifToken := p.previous()
 
-		// Save state before parsing elif condition
-		current = p.current
-		errorCount = len(p.errors)
-
-		// Try parsing condition with map access enabled
-		elifCondition := p.parseExpression()
+		elifCondition := p.parseBlockExpression()
 		if elifCondition == nil {
 			p.error("Expected condition after 'elif'")
 			return nil
 		}
 
-		// If we got a map access but no LEFT_BRACE follows, try again without map access
-		if _, isMapAccess := elifCondition.(*expression.MapAccessExpression); isMapAccess {
-			if !p.check(lexing.LEFT_BRACE) {
-				// Restore state and try again with map access disabled
-				p.current = current
-				p.errors = p.errors[:errorCount]
-				p.DisableMapAccess()
-				elifCondition = p.parseExpression()
-				p.EnableMapAccess()
-			}
-		}
-
 		if !p.match(lexing.LEFT_BRACE) {
 			p.error("Expected '{' after 'elif' condition")
 			return nil
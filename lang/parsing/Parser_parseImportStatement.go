@@ -0,0 +1,117 @@
+package parsing
+
+import (
+	"zen/lang/lexing"
+	"zen/lang/parsing/ast"
+	"zen/lang/parsing/statement"
+)
+
+// checkModuleStatement returns true if the current token starts a package declaration or an import statement,
+// which are only allowed at the top level
+func (p *Parser) checkModuleStatement() bool {
+	return p.checkKeyword("package") || p.checkKeyword("import") || p.checkKeyword("from")
+}
+
+// parseModuleStatement parses a package declaration or an import statement
+func (p *Parser) parseModuleStatement() ast.Statement {
+	switch p.advance().Literal {
+	case "package":
+		return p.parsePackageDeclaration()
+	case "import":
+		return p.parseImportStatement()
+	default:
+		return p.parseFromImportStatement()
+	}
+}
+
+// parsePackageDeclaration parses the name of a package
+// Syntax: package Name
+func (p *Parser) parsePackageDeclaration() ast.Statement {
+	startToken := p.previous() // The 'package' token
+
+	name := p.consume(lexing.IDENTIFIER, "Expected package name after 'package'")
+	if len(p.errors) > 0 {
+		return nil
+	}
+
+	return statement.NewPackageDeclaration(name.Literal, startToken.Location)
+}
+
+// parseImportStatement parses importing a module or namespace
+// Syntax: import Package/Namespace/Module [as alias]
+func (p *Parser) parseImportStatement() ast.Statement {
+	startToken := p.previous() // The 'import' token
+
+	path := p.parseImportPath()
+	if path == nil {
+		return nil
+	}
+
+	alias := ""
+	if p.matchKeyword("as") {
+		name := p.consume(lexing.IDENTIFIER, "Expected alias after 'as'")
+		if len(p.errors) > 0 {
+			return nil
+		}
+		alias = name.Literal
+	}
+
+	return statement.NewImportStatement(path, alias, nil, startToken.Location)
+}
+
+// parseFromImportStatement parses importing selected symbols of a module or namespace
+// Syntax: from Package/Namespace/Module import symbol [as alias], ...
+func (p *Parser) parseFromImportStatement() ast.Statement {
+	startToken := p.previous() // The 'from' token
+
+	path := p.parseImportPath()
+	if path == nil {
+		return nil
+	}
+
+	if !p.matchKeyword("import") {
+		p.error("Expected 'import' after module path")
+		return nil
+	}
+
+	symbols := make([]*statement.ImportedSymbol, 0)
+	for {
+		name := p.consume(lexing.IDENTIFIER, "Expected symbol name to import")
+		if len(p.errors) > 0 {
+			return nil
+		}
+
+		symbol := &statement.ImportedSymbol{Name: name.Literal, Location: name.Location}
+		if p.matchKeyword("as") {
+			alias := p.consume(lexing.IDENTIFIER, "Expected alias after 'as'")
+			if len(p.errors) > 0 {
+				return nil
+			}
+			symbol.Alias = alias.Literal
+		}
+		symbols = append(symbols, symbol)
+
+		if !p.match(lexing.COMMA) {
+			break
+		}
+	}
+
+	return statement.NewImportStatement(path, "", symbols, startToken.Location)
+}
+
+// parseImportPath parses the slash separated names of a module or namespace, e.g. GameEngine/Core/Vector2
+func (p *Parser) parseImportPath() []string {
+	path := make([]string, 0)
+	for {
+		name := p.consume(lexing.IDENTIFIER, "Expected module name")
+		if len(p.errors) > 0 {
+			return nil
+		}
+		path = append(path, name.Literal)
+
+		if !p.match(lexing.DIVIDE) {
+			break
+		}
+	}
+	return path
+}
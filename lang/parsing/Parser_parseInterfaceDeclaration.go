@@ -0,0 +1,119 @@
+package parsing
+
+import (
+	"zen/lang/lexing"
+	"zen/lang/parsing/ast"
+	"zen/lang/parsing/expression"
+	"zen/lang/parsing/statement"
+)
+
+// parseInterfaceDeclaration parses an interface declaration
+// Syntax: interface Name[<T, ...>] { members }
+func (p *Parser) parseInterfaceDeclaration() ast.Statement {
+	startToken := p.previous() // The 'interface' token
+
+	name := p.consume(lexing.IDENTIFIER, "Expected interface name")
+	if len(p.errors) > 0 {
+		return nil
+	}
+
+	var typeParams []*expression.TypeParameter
+	if p.match(lexing.LESS) {
+		typeParams = p.parseTypeParameterDeclarations()
+		if typeParams == nil {
+			return nil
+		}
+	}
+
+	if !p.match(lexing.LEFT_BRACE) {
+		p.error("Expected '{' after interface name")
+		return nil
+	}
+
+	properties := make([]*statement.VarDeclarationNode, 0)
+	methods := make([]*statement.FuncDeclaration, 0)
+
+	for !p.check(lexing.RIGHT_BRACE) {
+		if p.isAtEnd() || p.check(lexing.EOF) {
+			p.error("Unterminated interface body - expected '}'")
+			return nil
+		}
+
+		property, method := p.parseInterfaceMember()
+		if property == nil && method == nil {
+			return nil
+		}
+		if property != nil {
+			properties = append(properties, property)
+		} else {
+			methods = append(methods, method)
+		}
+	}
+
+	if !p.match(lexing.RIGHT_BRACE) {
+		p.error("Expected '}' after interface body")
+		return nil
+	}
+
+	iface := statement.NewInterfaceDeclaration(name.Literal, properties, methods, startToken.Location)
+	iface.TypeParameters = typeParams
+	return iface
+}
+
+// parseInterfaceMember parses a single property or method signature of an interface.
+// Like class members, they are declared with or without 'var'/'const' (name: type) and 'func' (name(params): type),
+// but properties have no default value and methods have no body. Members may be protected, but not private
+// Returns nil for both if the member is invalid
+func (p *Parser) parseInterfaceMember() (*statement.VarDeclarationNode, *statement.FuncDeclaration) {
+	if p.checkKeyword("private") {
+		p.errorAtToken(p.peek(), "Interface members can't be private")
+		return nil, nil
+	}
+	visibility := p.parseVisibility()
+
+	startToken := p.peek()
+	isConstant := false
+	isProperty := p.matchKeyword("var", "const")
+	if isProperty {
+		isConstant = p.previous().Literal == "const"
+	}
+	async := !isProperty && p.matchKeyword("async")
+	isMethod := !isProperty && p.matchKeyword("func")
+
+	name := p.consume(lexing.IDENTIFIER, "Expected property or method declaration")
+	if len(p.errors) > 0 {
+		return nil, nil
+	}
+
+	// name(params): type
+	if !isProperty && p.check(lexing.LEFT_PAREN) {
+		signature := p.parseFuncSignature(name, async, startToken)
+		if signature != nil {
+			signature.Visibility = visibility
+		}
+		return nil, signature
+	}
+	if isMethod || async {
+		p.error("Expected '(' after method name")
+		return nil, nil
+	}
+
+	// name: type
+	if !p.match(lexing.COLON) {
+		p.errorAtToken(p.peek(), "Expected ':' or '(' after member name")
+		return nil, nil
+	}
+	propertyType := p.parseType()
+	if propertyType == nil {
+		return nil, nil
+	}
+	isNullable := p.match(lexing.QMARK)
+	if p.check(lexing.ASSIGN) {
+		p.error("Interface properties can't have a default value")
+		return nil, nil
+	}
+
+	property := statement.NewVarDeclarationNode(name.Literal, propertyType, nil, isConstant, isNullable, startToken.Location)
+	property.Visibility = visibility
+	return property, nil
+}
@@ -0,0 +1,159 @@
+package parsing
+
+import (
+	"zen/lang/lexing"
+	"zen/lang/parsing/ast"
+	"zen/lang/parsing/expression"
+)
+
+// isLambdaStart looks ahead from the current '{' token to determine whether it opens a lambda
+// rather than a map literal. A lambda has a (possibly empty) list of parameters followed by '->'.
+// Tokens are only inspected, never consumed.
+func (p *Parser) isLambdaStart() bool {
+	idx := p.current + 1 // skip the '{'
+
+	// { -> ... }
+	if idx < len(p.tokens) && p.tokens[idx].Type == lexing.ARROW {
+		return true
+	}
+
+	for idx < len(p.tokens) {
+		if p.tokens[idx].Type != lexing.IDENTIFIER {
+			return false
+		}
+		idx++
+
+		// Skip an optional type annotation, which may only consist of type tokens
+		if idx < len(p.tokens) && p.tokens[idx].Type == lexing.COLON {
+			idx++
+			depth := 0
+			for idx < len(p.tokens) {
+				token := p.tokens[idx]
+				if depth == 0 && (token.Type == lexing.COMMA || token.Type == lexing.ARROW) {
+					break
+				}
+				switch token.Type {
+				case lexing.LESS, lexing.LEFT_PAREN:
+					depth++
+				case lexing.GREATER, lexing.RIGHT_PAREN:
+					depth--
+				case lexing.KEYWORD, lexing.IDENTIFIER, lexing.INT, lexing.COMMA, lexing.QMARK, lexing.COLON:
+				default:
+					return false
+				}
+				idx++
+			}
+		}
+
+		if idx >= len(p.tokens) {
+			return false
+		}
+
+		switch p.tokens[idx].Type {
+		case lexing.ARROW:
+			return true
+		case lexing.COMMA:
+			idx++
+		default:
+			return false
+		}
+	}
+
+	return false
+}
+
+// parseLambda parses a lambda expression like { x, y -> x * y }
+// The current token must be the opening '{', see isLambdaStart
+func (p *Parser) parseLambda() ast.Expression {
+	openBrace := p.advance() // consume {
+
+	parameters := make([]expression.FuncParameterExpression, 0)
+
+	for !p.check(lexing.ARROW) {
+		name := p.consume(lexing.IDENTIFIER, "Expected lambda parameter name")
+		if name.Location == nil {
+			return nil
+		}
+
+		// Optional type annotation
+		var paramType ast.Expression
+		isNullable := false
+		if p.match(lexing.COLON) {
+			paramType = p.parseType()
+			if paramType == nil {
+				return nil
+			}
+			isNullable = p.match(lexing.QMARK)
+		}
+
+		parameters = append(parameters, *expression.NewFuncParameterExpression(
+			name.Literal,
+			paramType,
+			isNullable,
+			name.Location,
+			nil,
+		))
+
+		if !p.match(lexing.COMMA) {
+			break
+		}
+	}
+
+	if !p.match(lexing.ARROW) {
+		p.error("Expected '->' after lambda parameters")
+		return nil
+	}
+
+	body := p.parseBlock()
+
+	if !p.match(lexing.RIGHT_BRACE) {
+		p.error("Expected '}' after lambda body")
+		return nil
+	}
+
+	return expression.NewLambdaExpression(parameters, body, openBrace.Location)
+}
+
+// parseFunctionType parses a function type like func(int, string): bool
+// The 'func' keyword has already been consumed
+func (p *Parser) parseFunctionType() ast.Expression {
+	funcToken := p.previous()
+
+	if !p.match(lexing.LEFT_PAREN) {
+		p.error("Expected '(' after 'func' in function type")
+		return nil
+	}
+
+	parameterTypes := make([]ast.Expression, 0)
+	if !p.check(lexing.RIGHT_PAREN) {
+		for {
+			paramType := p.parseType()
+			if paramType == nil {
+				return nil
+			}
+			parameterTypes = append(parameterTypes, paramType)
+
+			if !p.match(lexing.COMMA) {
+				break
+			}
+		}
+	}
+
+	if !p.match(lexing.RIGHT_PAREN) {
+		p.error("Expected ')' after function type parameters")
+		return nil
+	}
+
+	// Optional return type (defaults to void)
+	var returnType ast.Expression
+	if p.match(lexing.COLON) {
+		returnType = p.parseType()
+		if returnType == nil {
+			return nil
+		}
+	} else {
+		returnType = expression.NewBasicType("void", funcToken.Location)
+	}
+
+	return expression.NewFunctionType(parameterTypes, returnType, funcToken.Location)
+}
@@ -3,6 +3,7 @@ package parsing
 import (
 	"zen/lang/lexing"
 	"zen/lang/parsing/ast"
+	"zen/lang/parsing/expression"
 	"zen/lang/parsing/statement"
 )
 
@@ -14,6 +15,19 @@ func (p *Parser) parseReturnStatement() *statement.ReturnStatmenet {
 
 	if !p.check(lexing.RIGHT_BRACE) {
 		exp = p.parseExpression()
+
+		// Multiple return values, e.g. return 404, "not found"
+		if exp != nil && p.check(lexing.COMMA) {
+			elements := []ast.Expression{exp}
+			for p.match(lexing.COMMA) {
+				element := p.parseExpression()
+				if element == nil {
+					return nil
+				}
+				elements = append(elements, element)
+			}
+			exp = expression.NewTupleExpression(elements, exp.GetLocation())
+		}
 	}
 
 	return &statement.ReturnStatmenet{
@@ -0,0 +1,130 @@
+package parsing
+
+import (
+	"zen/lang/lexing"
+	"zen/lang/parsing/ast"
+	"zen/lang/parsing/expression"
+	"zen/lang/parsing/statement"
+)
+
+// parseSelectStatement parses a select statement
+// Syntax: select { channel.receive() as name { body } channel.send(value) { body } ... else { body } }
+func (p *Parser) parseSelectStatement() ast.Statement {
+	startToken := p.previous() // The 'select' token
+
+	if !p.match(lexing.LEFT_BRACE) {
+		p.error("Expected '{' after 'select'")
+		return nil
+	}
+
+	arms := make([]*statement.SelectArm, 0)
+	hasElse := false
+	var elseBody []ast.Statement
+
+	for !p.check(lexing.RIGHT_BRACE) {
+		if p.isAtEnd() || p.check(lexing.EOF) {
+			p.error("Unterminated select statement - expected '}'")
+			return nil
+		}
+
+		if hasElse {
+			p.errorAtToken(p.peek(), "The 'else' arm must be the last arm of a select statement")
+			return nil
+		}
+
+		// Parse the else arm
+		if p.matchKeyword("else") {
+			if !p.match(lexing.LEFT_BRACE) {
+				p.error("Expected '{' after 'else'")
+				return nil
+			}
+
+			elseBody = p.parseBlock()
+
+			if !p.match(lexing.RIGHT_BRACE) {
+				p.error("Expected '}' after else body")
+				return nil
+			}
+			hasElse = true
+			continue
+		}
+
+		arm := p.parseSelectArm()
+		if arm == nil {
+			return nil
+		}
+		arms = append(arms, arm)
+	}
+
+	if !p.match(lexing.RIGHT_BRACE) {
+		p.error("Expected '}' after select arms")
+		return nil
+	}
+
+	if len(arms) == 0 {
+		p.errorAtToken(startToken, "A select statement needs at least one send or receive arm")
+		return nil
+	}
+
+	return statement.NewSelectStatement(arms, hasElse, elseBody, startToken.Location)
+}
+
+// parseSelectArm parses the operation and body of a single select arm, which is either
+// a send, e.g. jobs.send(job), or a receive optionally naming the received value, e.g. results.receive() as result
+func (p *Parser) parseSelectArm() *statement.SelectArm {
+	location := p.peek().Location
+
+	expr := p.parseBlockExpression()
+	if expr == nil {
+		return nil
+	}
+
+	call, ok := expr.(*expression.CallExpression)
+	var member *expression.MemberAccessExpression
+	if ok {
+		member, ok = call.Callee.(*expression.MemberAccessExpression)
+	}
+	if !ok || (member.Property != "send" && member.Property != "receive") {
+		p.errorAtToken(p.previous(), "Expected a send or receive on a channel in select arm")
+		return nil
+	}
+
+	send := member.Property == "send"
+	var value ast.Expression
+	if send {
+		if len(call.Arguments) != 1 {
+			p.errorAtToken(p.previous(), "Expected a single value to send in select arm")
+			return nil
+		}
+		value = call.Arguments[0]
+	} else if len(call.Arguments) != 0 {
+		p.errorAtToken(p.previous(), "Expected no arguments to receive in select arm")
+		return nil
+	}
+
+	alias := ""
+	if p.matchKeyword("as") {
+		if send {
+			p.errorAtToken(p.previous(), "Only received values can be named in select arm")
+			return nil
+		}
+		alias = p.consume(lexing.IDENTIFIER, "Expected name after 'as'").Literal
+		if len(p.errors) > 0 {
+			return nil
+		}
+	}
+
+	if !p.match(lexing.LEFT_BRACE) {
+		p.error("Expected '{' after select arm")
+		return nil
+	}
+
+	body := p.parseBlock()
+
+	if !p.match(lexing.RIGHT_BRACE) {
+		p.error("Expected '}' after select arm body")
+		return nil
+	}
+
+	return statement.NewSelectArm(member.Object, send, value, alias, body, location)
+}
@@ -8,6 +8,18 @@ import (
 
 // parseStatement parses a statement by delegating to other methods like parseVarDeclaration, parseIfStatement etc.
 func (p *Parser) parseStatement() ast.Statement {
+	// visibility modifiers are only parsed at the top level by Parse and in class bodies
+	if p.checkVisibility() {
+		p.errorAtToken(p.peek(), "'"+p.peek().Literal+"' is only allowed on top-level declarations and class members")
+		return nil
+	}
+
+	// package declarations and imports are only parsed at the top level by Parse
+	if p.checkModuleStatement() {
+		p.errorAtToken(p.peek(), "'"+p.peek().Literal+"' is only allowed at the top level")
+		return nil
+	}
+
 	// var/const declaration
 	if p.matchKeyword("var", "const") {
 		return p.parseVarDeclaration()
@@ -27,6 +39,21 @@ func (p *Parser) parseStatement() ast.Statement {
 		return p.parseFuncDeclaration(false)
 	}
 
+	// class declaration
+	if p.matchKeyword("class") {
+		return p.parseClassDeclaration()
+	}
+
+	// trait declaration
+	if p.matchKeyword("trait") {
+		return p.parseTraitDeclaration()
+	}
+
+	// interface declaration
+	if p.matchKeyword("interface") {
+		return p.parseInterfaceDeclaration()
+	}
+
 	// If Statement
 	if p.matchKeyword("if") {
 		return p.parseIfStatement()
@@ -61,6 +88,16 @@ func (p *Parser) parseStatement() ast.Statement {
 		return p.parseWhileStatement()
 	}
 
+	// When Statement
+	if p.matchKeyword("when") {
+		return p.parseWhenStatement()
+	}
+
+	// Select Statement
+	if p.matchKeyword("select") {
+		return p.parseSelectStatement()
+	}
+
 	// Break Statement
 	if p.matchKeyword("break") {
 		return p.parseBreakStatement()
@@ -76,6 +113,21 @@ func (p *Parser) parseStatement() ast.Statement {
 		return p.parseReturnStatement()
 	}
 
+	// Throw statement
+	if p.matchKeyword("throw") {
+		return p.parseThrowStatement()
+	}
+
+	// Try statement
+	if p.matchKeyword("try") {
+		return p.parseTryStatement()
+	}
+
+	// Defer statement
+	if p.matchKeyword("defer") {
+		return p.parseDeferStatement()
+	}
+
 	// Try parsing an expression statement
 	expr := p.parseExpression()
 	if expr != nil {
@@ -0,0 +1,50 @@
+package parsing
+
+import (
+	"zen/lang/lexing"
+	"zen/lang/parsing/ast"
+	"zen/lang/parsing/statement"
+)
+
+// parseTraitDeclaration parses a trait declaration, whose members are declared like those of a class
+// Syntax: trait Name { members }
+func (p *Parser) parseTraitDeclaration() ast.Statement {
+	startToken := p.previous() // The 'trait' token
+
+	name := p.consume(lexing.IDENTIFIER, "Expected trait name")
+	if len(p.errors) > 0 {
+		return nil
+	}
+
+	if !p.match(lexing.LEFT_BRACE) {
+		p.error("Expected '{' after trait name")
+		return nil
+	}
+
+	fields := make([]*statement.VarDeclarationNode, 0)
+	methods := make([]*statement.FuncDeclaration, 0)
+
+	for !p.check(lexing.RIGHT_BRACE) {
+		if p.isAtEnd() || p.check(lexing.EOF) {
+			p.error("Unterminated trait body - expected '}'")
+			return nil
+		}
+
+		field, method := p.parseClassMember()
+		if field == nil && method == nil {
+			return nil
+		}
+		if field != nil {
+			fields = append(fields, field)
+		} else {
+			methods = append(methods, method)
+		}
+	}
+
+	if !p.match(lexing.RIGHT_BRACE) {
+		p.error("Expected '}' after trait body")
+		return nil
+	}
+
+	return statement.NewTraitDeclaration(name.Literal, fields, methods, startToken.Location)
+}
@@ -0,0 +1,106 @@
+package parsing
+
+import (
+	"zen/lang/lexing"
+	"zen/lang/parsing/ast"
+	"zen/lang/parsing/statement"
+)
+
+// parseThrowStatement parses throwing an exception
+// Syntax: throw expression
+func (p *Parser) parseThrowStatement() ast.Statement {
+	startToken := p.previous() // The 'throw' token
+
+	exp := p.parseExpression()
+	if exp == nil {
+		p.error("Expected exception after 'throw'")
+		return nil
+	}
+
+	return statement.NewThrowStatement(exp, startToken.Location)
+}
+
+// parseTryStatement parses a try statement, which needs at least one catch clause or a finally block
+// Syntax: try { body } catch e: Type { handler } catch e { handler } finally { cleanup }
+func (p *Parser) parseTryStatement() ast.Statement {
+	startToken := p.previous() // The 'try' token
+
+	body := p.parseTryBlock("try")
+	if body == nil {
+		return nil
+	}
+
+	catches := make([]*statement.CatchClause, 0)
+	for p.matchKeyword("catch") {
+		catch := p.parseCatchClause()
+		if catch == nil {
+			return nil
+		}
+		if len(catches) > 0 && catches[len(catches)-1].Type == nil {
+			p.errorAtToken(p.previous(), "Unreachable catch clause after a catch clause without a type")
+			return nil
+		}
+		catches = append(catches, catch)
+	}
+
+	var finally []ast.Statement
+	if p.matchKeyword("finally") {
+		finally = p.parseTryBlock("finally")
+		if finally == nil {
+			return nil
+		}
+	}
+
+	if len(catches) == 0 && finally == nil {
+		p.error("Expected 'catch' or 'finally' after try block")
+		return nil
+	}
+
+	return statement.NewTryStatement(body, catches, finally, startToken.Location)
+}
+
+// parseCatchClause parses the name, optional type and body of a catch clause, e.g. catch e: NotFoundException { ... }
+func (p *Parser) parseCatchClause() *statement.CatchClause {
+	startToken := p.previous() // The 'catch' token
+
+	name := p.consume(lexing.IDENTIFIER, "Expected exception name after 'catch'")
+	if len(p.errors) > 0 {
+		return nil
+	}
+
+	var exceptionType ast.Expression
+	if p.match(lexing.COLON) {
+		exceptionType = p.parseType()
+		if exceptionType == nil {
+			return nil
+		}
+	}
+
+	body := p.parseTryBlock("catch")
+	if body == nil {
+		return nil
+	}
+
+	return &statement.CatchClause{
+		Name:     name.Literal,
+		Type:     exceptionType,
+		Body:     body,
+		Location: startToken.Location,
+	}
+}
+
+// parseTryBlock parses the braced body of a try, catch or finally block, which is empty but not nil for empty braces
+func (p *Parser) parseTryBlock(keyword string) []ast.Statement {
+	if !p.match(lexing.LEFT_BRACE) {
+		p.error("Expected '{' after '" + keyword + "'")
+		return nil
+	}
+
+	body := p.parseBlock()
+
+	if !p.match(lexing.RIGHT_BRACE) {
+		p.error("Expected '}' after " + keyword + " block")
+		return nil
+	}
+	return body
+}
@@ -2,14 +2,37 @@ package parsing
 
 import (
 	"strconv"
-	"zen/lang/common"
 	"zen/lang/lexing"
 	"zen/lang/parsing/ast"
 	"zen/lang/parsing/expression"
 )
 
-// parseType parses a type annotation, which can be either a basic type or a parametric type
+// parseType parses a type annotation, which can be a basic type, a parametric type, a function type
+// or a union of those, e.g. string | int
 func (p *Parser) parseType() ast.Expression {
+	first := p.parseSingleType()
+	if first == nil || !p.check(lexing.PIPE) {
+		return first
+	}
+
+	types := []ast.Expression{first}
+	for p.match(lexing.PIPE) {
+		typ := p.parseSingleType()
+		if typ == nil {
+			return nil
+		}
+		types = append(types, typ)
+	}
+	return expression.NewUnionType(types, first.GetLocation())
+}
+
+// parseSingleType parses a basic type, a parametric type or a function type
+func (p *Parser) parseSingleType() ast.Expression {
+	// Function types, e.g. func(int, int): int
+	if p.matchKeyword("func") {
+		return p.parseFunctionType()
+	}
+
 	// Parse the base type name, which can be either a keyword (primitive) or identifier (user type)
 	var typeToken lexing.Token
 	if p.check(lexing.KEYWORD) {
@@ -28,7 +51,17 @@ func (p *Parser) parseType() ast.Expression {
 		return expression.NewBasicType(typeToken.Literal, typeToken.Location)
 	}
 
-	// Parse parametric type parameters
+	params := p.parseTypeArguments()
+	if params == nil {
+		return nil
+	}
+
+	return expression.NewParametricType(typeToken.Literal, params, typeToken.Location)
+}
+
+// parseTypeArguments parses the parameters of a parametric type up to and including the closing '>',
+// after the opening '<' has been matched, e.g. the int, 5 in Array<int, 5>
+func (p *Parser) parseTypeArguments() []expression.Parameter {
 	var params []expression.Parameter
 
 	// Must have at least one parameter
@@ -65,20 +98,55 @@ func (p *Parser) parseType() ast.Expression {
 		return nil
 	}
 
-	return expression.NewParametricType(typeToken.Literal, params, typeToken.Location)
+	return params
+}
+
+// parseTypeParameterDeclarations parses the type parameters of a generic class or function
+// up to and including the closing '>', after the opening '<' has been matched.
+// Syntax: <T, U, N: int>
+func (p *Parser) parseTypeParameterDeclarations() []*expression.TypeParameter {
+	var params []*expression.TypeParameter
+	for {
+		name := p.consume(lexing.IDENTIFIER, "Expected type parameter name")
+		if len(p.errors) > 0 {
+			return nil
+		}
+		for _, param := range params {
+			if param.Name == name.Literal {
+				p.errorAtToken(name, "Duplicate type parameter '"+name.Literal+"'")
+				return nil
+			}
+		}
+
+		// Value parameters declare their type, e.g. N: int
+		var typ ast.Expression
+		if p.match(lexing.COLON) {
+			typ = p.parseType()
+			if typ == nil {
+				return nil
+			}
+		}
+		params = append(params, expression.NewTypeParameter(name.Literal, typ, name.Location))
+
+		if !p.match(lexing.COMMA) {
+			break
+		}
+	}
+
+	if !p.match(lexing.GREATER) {
+		p.error("Expected '>' after type parameters")
+		return nil
+	}
+	return params
 }
 
 // parseTypeParameter parses a single type parameter, which can be:
-// - A type name (keyword or identifier)
-// - A nested parametric type
 // - An integer literal
+// - Any type, e.g. int, Array<int>, func(): int or int | string, optionally nullable, e.g. int?
 func (p *Parser) parseTypeParameter() *expression.Parameter {
-	var location *common.SourceLocation
-
 	// Try to parse an integer parameter first
 	if p.check(lexing.INT) {
 		token := p.advance()
-		location = token.Location
 		value, err := strconv.ParseInt(token.Literal, 10, 64)
 		if err != nil {
 			p.errorAtToken(token, "Invalid integer literal")
@@ -87,67 +155,28 @@ func (p *Parser) parseTypeParameter() *expression.Parameter {
 		return &expression.Parameter{
 			Value:    value,
 			IsType:   false,
-			Location: location,
+			Location: token.Location,
 		}
 	}
 
-	// Try to parse a type parameter (keyword, identifier, or nested type)
-	if p.check(lexing.KEYWORD) || p.check(lexing.IDENTIFIER) {
-		token := p.advance()
-		location = token.Location
-
-		// If it's followed by a less-than, it's a nested parametric type
-		if p.check(lexing.LESS) {
-			// Parse the nested type
-			if !p.match(lexing.LESS) {
-				p.error("Expected '<' after type name")
-				return nil
-			}
-
-			var params []expression.Parameter
-
-			// Parse first parameter
-			param := p.parseTypeParameter()
-			if param == nil {
-				return nil
-			}
-			params = append(params, *param)
-
-			// Parse additional parameters
-			for p.match(lexing.COMMA) {
-				if p.check(lexing.GREATER) {
-					p.error("Unexpected trailing comma")
-					return nil
-				}
-
-				param = p.parseTypeParameter()
-				if param == nil {
-					return nil
-				}
-				params = append(params, *param)
-			}
-
-			// Expect closing angle bracket
-			if !p.match(lexing.GREATER) {
-				p.error("Expected '>' after type parameters")
-				return nil
-			}
-
-			return &expression.Parameter{
-				Value:    expression.NewParametricType(token.Literal, params, location),
-				IsType:   true,
-				Location: location,
-			}
-		}
-
-		// Otherwise it's a basic type
-		return &expression.Parameter{
-			Value:    token.Literal,
-			IsType:   true,
-			Location: location,
-		}
+	if !p.check(lexing.KEYWORD) && !p.check(lexing.IDENTIFIER) {
+		p.error("Expected type name or integer")
+		return nil
 	}
 
-	p.error("Expected type name or integer")
-	return nil
+	typ := p.parseType()
+	if typ == nil {
+		return nil
+	}
+	param := &expression.Parameter{
+		Value:    typ,
+		IsType:   true,
+		Nullable: p.match(lexing.QMARK),
+		Location: typ.GetLocation(),
+	}
+	// Type names are kept as strings, as they may name an integer, e.g. INF or a value type parameter
+	if basic, ok := typ.(*expression.BasicType); ok {
+		param.Value = basic.Name
+	}
+	return param
 }
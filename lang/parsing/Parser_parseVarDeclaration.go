@@ -17,6 +17,25 @@ func (p *Parser) parseVarDeclaration() ast.Statement {
 		return nil
 	}
 
+	if p.check(lexing.COMMA) || p.check(lexing.LESS_EQUALS) {
+		destructuring := p.parseDestructuringDeclaration(name, isConstant, startToken)
+		if destructuring == nil {
+			return nil
+		}
+		return destructuring
+	}
+
+	decl := p.parseVarDeclarationRest(name, isConstant, startToken)
+	if decl == nil {
+		// Avoid returning a typed nil
+		return nil
+	}
+	return decl
+}
+
+// parseVarDeclarationRest parses the optional type annotation and initializer following a variable's name.
+// It is shared by variable declarations and class fields, which don't need the 'var' keyword
+func (p *Parser) parseVarDeclarationRest(name lexing.Token, isConstant bool, startToken lexing.Token) *statement.VarDeclarationNode {
 	isNullable := false
 	var varType ast.Expression
 
@@ -51,3 +70,34 @@ func (p *Parser) parseVarDeclaration() ast.Statement {
 		startToken.Location,
 	)
 }
+
+// parseDestructuringDeclaration parses the remaining names and the initializer of a destructuring declaration,
+// e.g. var statusCode, body <= httpRequest()
+func (p *Parser) parseDestructuringDeclaration(name lexing.Token, isConstant bool, startToken lexing.Token) *statement.DestructuringDeclaration {
+	names := []string{name.Literal}
+	for p.match(lexing.COMMA) {
+		next := p.consume(lexing.IDENTIFIER, "Expected variable name after ','")
+		if len(p.errors) > 0 {
+			return nil
+		}
+		for _, existing := range names {
+			if existing == next.Literal && existing != "_" {
+				p.error("Duplicate variable name '" + next.Literal + "' in destructuring declaration")
+				return nil
+			}
+		}
+		names = append(names, next.Literal)
+	}
+
+	p.consume(lexing.LESS_EQUALS, "Expected '<=' after variable names")
+	if len(p.errors) > 0 {
+		return nil
+	}
+
+	initializer := p.parseExpression()
+	if initializer == nil {
+		return nil
+	}
+
+	return statement.NewDestructuringDeclaration(names, initializer, isConstant, startToken.Location)
+}
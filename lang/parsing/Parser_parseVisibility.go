@@ -0,0 +1,58 @@
+package parsing
+
+import (
+	"zen/lang/parsing/ast"
+	"zen/lang/parsing/statement"
+)
+
+// checkVisibility returns true if the current token is a visibility modifier (pub, private or protected)
+func (p *Parser) checkVisibility() bool {
+	return p.checkKeyword("pub") || p.checkKeyword("private") || p.checkKeyword("protected")
+}
+
+// parseVisibility consumes an optional visibility modifier and returns the visibility it names.
+// Declarations without a modifier are public
+func (p *Parser) parseVisibility() ast.Visibility {
+	if p.matchKeyword("pub", "private", "protected") {
+		return ast.VisibilityOf(p.previous().Literal)
+	}
+	return ast.Public
+}
+
+// parseTopLevelDeclaration parses a var, const, func, class, interface or trait declaration preceded by a visibility modifier.
+// Private top-level declarations can't be imported by other modules. 'protected' only applies to class members
+// Syntax: (pub | private) declaration
+func (p *Parser) parseTopLevelDeclaration() ast.Statement {
+	modifier := p.advance()
+	visibility := ast.VisibilityOf(modifier.Literal)
+	if visibility == ast.Protected {
+		p.errorAtToken(modifier, "'protected' is only allowed on class members")
+		return nil
+	}
+
+	if !p.checkKeyword("var") && !p.checkKeyword("const") && !p.checkKeyword("async") && !p.checkKeyword("func") &&
+		!p.checkKeyword("class") && !p.checkKeyword("interface") && !p.checkKeyword("trait") {
+		p.errorAtToken(p.peek(), "Expected declaration after '"+modifier.Literal+"'")
+		return nil
+	}
+
+	stmt := p.parseStatement()
+	if len(p.errors) > 0 {
+		return nil
+	}
+	switch declaration := stmt.(type) {
+	case *statement.VarDeclarationNode:
+		declaration.Visibility = visibility
+	case *statement.DestructuringDeclaration:
+		declaration.Visibility = visibility
+	case *statement.FuncDeclaration:
+		declaration.Visibility = visibility
+	case *statement.ClassDeclaration:
+		declaration.Visibility = visibility
+	case *statement.InterfaceDeclaration:
+		declaration.Visibility = visibility
+	case *statement.TraitDeclaration:
+		declaration.Visibility = visibility
+	}
+	return stmt
+}
@@ -0,0 +1,167 @@
+package parsing
+
+import (
+	"zen/lang/lexing"
+	"zen/lang/parsing/ast"
+	"zen/lang/parsing/expression"
+	"zen/lang/parsing/statement"
+)
+
+// parseWhenStatement parses a when statement
+// Syntax: when subject { pattern or pattern { body } ... else { body } }
+func (p *Parser) parseWhenStatement() ast.Statement {
+	startToken := p.previous() // The 'when' token
+
+	subject := p.parseBlockExpression()
+	if subject == nil {
+		p.error("Expected expression after 'when'")
+		return nil
+	}
+
+	if !p.match(lexing.LEFT_BRACE) {
+		p.error("Expected '{' after 'when' subject")
+		return nil
+	}
+
+	arms := make([]*statement.WhenArm, 0)
+	hasElse := false
+	var elseBody []ast.Statement
+
+	for !p.check(lexing.RIGHT_BRACE) {
+		if p.isAtEnd() || p.check(lexing.EOF) {
+			p.error("Unterminated when statement - expected '}'")
+			return nil
+		}
+
+		if hasElse {
+			p.errorAtToken(p.peek(), "The 'else' arm must be the last arm of a when statement")
+			return nil
+		}
+
+		// Parse the else arm
+		if p.matchKeyword("else") {
+			if !p.match(lexing.LEFT_BRACE) {
+				p.error("Expected '{' after 'else'")
+				return nil
+			}
+
+			elseBody = p.parseBlock()
+
+			if !p.match(lexing.RIGHT_BRACE) {
+				p.error("Expected '}' after else body")
+				return nil
+			}
+			hasElse = true
+			continue
+		}
+
+		arm := p.parseWhenArm()
+		if arm == nil {
+			return nil
+		}
+		arms = append(arms, arm)
+	}
+
+	if !p.match(lexing.RIGHT_BRACE) {
+		p.error("Expected '}' after when arms")
+		return nil
+	}
+
+	return statement.NewWhenStatement(subject, arms, hasElse, elseBody, startToken.Location)
+}
+
+// parseWhenArm parses the patterns and body of a single when arm
+// Syntax: pattern or pattern { body }
+func (p *Parser) parseWhenArm() *statement.WhenArm {
+	location := p.peek().Location
+
+	patterns := make([]*statement.WhenPattern, 0)
+	for {
+		pattern := p.parseWhenPattern()
+		if pattern == nil {
+			return nil
+		}
+		patterns = append(patterns, pattern)
+
+		if !p.matchKeyword("or") {
+			break
+		}
+	}
+
+	if !p.match(lexing.LEFT_BRACE) {
+		p.error("Expected '{' after when pattern")
+		return nil
+	}
+
+	body := p.parseBlock()
+
+	if !p.match(lexing.RIGHT_BRACE) {
+		p.error("Expected '}' after when arm body")
+		return nil
+	}
+
+	return statement.NewWhenArm(patterns, body, location)
+}
+
+// parseWhenPattern parses a single pattern of a when arm, which is either:
+// - a type pattern, e.g. is int
+// - a structural pattern, e.g. has Drawable, which is a predicate on the subject
+// - a predicate referring to the subject with a leading dot, e.g. .length > 5
+// - a value or range, e.g. "john" or 0..12
+func (p *Parser) parseWhenPattern() *statement.WhenPattern {
+	location := p.peek().Location
+
+	if p.matchKeyword("is") {
+		typ := p.parseType()
+		if typ == nil {
+			return nil
+		}
+		return statement.NewWhenPattern(statement.WhenType, nil, typ, location)
+	}
+
+	if p.check(lexing.IDENTIFIER) && p.peek().Literal == "has" && p.peekNext().Type == lexing.IDENTIFIER {
+		subject := expression.NewWhenSubjectExpression(location)
+		value := p.parseHasExpression(subject, p.advance())
+		if value == nil {
+			return nil
+		}
+		return statement.NewWhenPattern(statement.WhenPredicate, value, nil, location)
+	}
+
+	// Patterns are separated by 'or', so they are parsed below the logical or.
+	// As with parseBlockExpression, the arm's '{' may be mistaken for map access,
+	// in which case the pattern is parsed again with map access disabled
+	current := p.current
+	errorCount := len(p.errors)
+
+	value, isPredicate := p.parseWhenPatternValue()
+	if value != nil && !p.check(lexing.LEFT_BRACE) && !p.checkKeyword("or") {
+		p.current = current
+		p.errors = p.errors[:errorCount]
+		p.DisableMapAccess()
+		value, isPredicate = p.parseWhenPatternValue()
+		p.EnableMapAccess()
+	}
+	if value == nil {
+		return nil
+	}
+
+	if isPredicate {
+		return statement.NewWhenPattern(statement.WhenPredicate, value, nil, location)
+	}
+	return statement.NewWhenPattern(statement.WhenValue, value, nil, location)
+}
+
+// parseWhenPatternValue parses the expression of a value or predicate pattern.
+// Returns true if it refers to the when subject with a leading dot, making it a predicate
+func (p *Parser) parseWhenPatternValue() (ast.Expression, bool) {
+	enabled, used := p.whenSubjectEnabled, p.whenSubjectUsed
+	p.whenSubjectEnabled = true
+	p.whenSubjectUsed = false
+
+	value := p.parseLogicalAnd()
+	isPredicate := p.whenSubjectUsed
+
+	p.whenSubjectEnabled, p.whenSubjectUsed = enabled, used
+	return value, isPredicate
+}
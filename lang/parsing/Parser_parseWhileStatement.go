@@ -12,7 +12,7 @@ func (p *Parser) parseWhileStatement() ast.Statement {
 	startToken := p.previous() // The 'while' token
 
 	// Parse condition
-	condition := p.parseExpression()
+	condition := p.parseBlockExpression()
 	if condition == nil {
 		p.error("Expected condition after 'while'")
 		return nil
@@ -0,0 +1,44 @@
+package ast
+
+// Visibility controls where a declaration can be accessed from.
+// Class members are public unless declared private or protected.
+// Top-level declarations are public unless declared private, which keeps them from being imported by other modules
+type Visibility int
+
+const (
+	// Public declarations are accessible from anywhere, 'pub' declares this explicitly
+	Public Visibility = iota
+	// Protected class members are accessible from methods of the declaring class and its subclasses
+	Protected
+	// Private class members are accessible from methods of the declaring class only
+	Private
+)
+
+// VisibilityOf returns the visibility named by a modifier keyword (pub, protected or private)
+func VisibilityOf(modifier string) Visibility {
+	switch modifier {
+	case "private":
+		return Private
+	case "protected":
+		return Protected
+	default:
+		return Public
+	}
+}
+
+// String returns the modifier keyword of the visibility
+func (v Visibility) String() string {
+	switch v {
+	case Private:
+		return "private"
+	case Protected:
+		return "protected"
+	default:
+		return "pub"
+	}
+}
+
+// IsNarrowerThan returns true if the visibility allows access from fewer places than other
+func (v Visibility) IsNarrowerThan(other Visibility) bool {
+	return v > other
+}
@@ -57,6 +57,29 @@ type Visitor interface {
 	VisitParametricType(node Expression) interface{}
 	VisitBasicType(node Expression) interface{}
 	VisitAwait(node Expression) interface{}
+	VisitSpawn(node Expression) interface{}
+	VisitLambda(node Expression) interface{}
+	VisitFunctionType(node Expression) interface{}
+	VisitRange(node Expression) interface{}
+	VisitWhere(node Expression) interface{}
+	VisitUnionType(node Expression) interface{}
+	VisitWhenSubject(node Expression) interface{}
+	VisitWhenStatement(node Statement) interface{}
+	VisitClassDeclaration(node Statement) interface{}
+	VisitNew(node Expression) interface{}
+	VisitInterfaceDeclaration(node Statement) interface{}
+	VisitIs(node Expression) interface{}
+	VisitHas(node Expression) interface{}
+	VisitTraitDeclaration(node Statement) interface{}
+	VisitTupleType(node Expression) interface{}
+	VisitTuple(node Expression) interface{}
+	VisitDestructuringDeclaration(node Statement) interface{}
+	VisitThrowStatement(node Statement) interface{}
+	VisitTryStatement(node Statement) interface{}
+	VisitDeferStatement(node Statement) interface{}
+	VisitPackageDeclaration(node Statement) interface{}
+	VisitImportStatement(node Statement) interface{}
+	VisitSelectStatement(node Statement) interface{}
 }
 
 // ProgramNode represents the root node of the AST
@@ -11,7 +11,9 @@ import (
 type CallExpression struct {
 	Callee    ast.Expression
 	Arguments []ast.Expression
-	Location  *common.SourceLocation
+	// TypeArguments are the explicit type arguments of a call to a generic function or class, e.g. int in first<int>(xs)
+	TypeArguments []Parameter
+	Location      *common.SourceLocation
 }
 
 func NewCallExpression(callee *IdentifierExpression, arguments []ast.Expression, location *common.SourceLocation) *CallExpression {
@@ -36,6 +38,9 @@ func (e *CallExpression) String(indent int) string {
 	var sb strings.Builder
 	sb.WriteString(fmt.Sprintf("%sCall\n", strings.Repeat("  ", indent)))
 	sb.WriteString(fmt.Sprintf("%sCallee:\n%s", strings.Repeat("  ", indent+1), e.Callee.String(indent+2)))
+	if len(e.TypeArguments) > 0 {
+		sb.WriteString(fmt.Sprintf("%sTypeArguments: %s\n", strings.Repeat("  ", indent+1), FormatTypeArguments(e.TypeArguments)))
+	}
 	if len(e.Arguments) > 0 {
 		sb.WriteString(fmt.Sprintf("%sArguments:\n", strings.Repeat("  ", indent+1)))
 		for _, arg := range e.Arguments {
@@ -41,7 +41,9 @@ func (n *FuncParameterExpression) String(indent int) string {
 	sb.WriteString(indentStr + "  FuncParameterExpression:\n")
 
 	sb.WriteString(indentStr + "    Name: " + n.Name + "\n")
-	sb.WriteString(indentStr + "    Type: " + n.Type.String(indent+2) + "\n")
+	if n.Type != nil {
+		sb.WriteString(indentStr + "    Type: " + n.Type.String(indent+2) + "\n")
+	}
 	if n.DefaultValue != nil {
 		sb.WriteString(indentStr + "    DefaultValue:")
 		sb.WriteString(n.DefaultValue.String(indent+2) + "\n")
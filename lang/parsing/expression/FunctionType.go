@@ -0,0 +1,41 @@
+package expression
+
+import (
+	"strings"
+	"zen/lang/common"
+	"zen/lang/parsing/ast"
+)
+
+// FunctionType represents the type of a function or lambda, e.g. func(int, int): int
+type FunctionType struct {
+	ParameterTypes []ast.Expression
+	// ReturnType is a BasicType "void" if the function type doesn't declare one
+	ReturnType ast.Expression
+	Location   *common.SourceLocation
+}
+
+func NewFunctionType(parameterTypes []ast.Expression, returnType ast.Expression, location *common.SourceLocation) *FunctionType {
+	return &FunctionType{
+		ParameterTypes: parameterTypes,
+		ReturnType:     returnType,
+		Location:       location,
+	}
+}
+
+func (t *FunctionType) Accept(visitor ast.Visitor) interface{} {
+	return visitor.VisitFunctionType(t)
+}
+
+func (t *FunctionType) GetLocation() *common.SourceLocation {
+	return t.Location
+}
+
+func (t *FunctionType) IsExpression() {}
+
+func (t *FunctionType) String(indent int) string {
+	params := make([]string, len(t.ParameterTypes))
+	for i, param := range t.ParameterTypes {
+		params[i] = param.String(0)
+	}
+	return strings.Repeat("  ", indent) + "func(" + strings.Join(params, ", ") + "): " + t.ReturnType.String(0)
+}
@@ -0,0 +1,50 @@
+package expression
+
+import (
+	"fmt"
+	"strings"
+	"zen/lang/common"
+	"zen/lang/parsing/ast"
+)
+
+// HasExpression tests whether a value structurally matches an interface, e.g. shape has Drawable
+// or a single member of it, e.g. shape has Drawable.draw
+type HasExpression struct {
+	Value     ast.Expression
+	Interface ast.Expression
+	// Member is the name of the only member to check, or "" to check all members
+	Member   string
+	Location *common.SourceLocation
+}
+
+func NewHasExpression(value ast.Expression, iface ast.Expression, member string, location *common.SourceLocation) *HasExpression {
+	return &HasExpression{
+		Value:     value,
+		Interface: iface,
+		Member:    member,
+		Location:  location,
+	}
+}
+
+func (e *HasExpression) Accept(visitor ast.Visitor) interface{} {
+	return visitor.VisitHas(e)
+}
+
+func (e *HasExpression) GetLocation() *common.SourceLocation {
+	return e.Location
+}
+
+func (e *HasExpression) IsExpression() {}
+
+func (e *HasExpression) String(indent int) string {
+	var sb strings.Builder
+	indentStr := strings.Repeat("  ", indent)
+	if e.Member != "" {
+		sb.WriteString(fmt.Sprintf("%sHas (member %s)\n", indentStr, e.Member))
+	} else {
+		sb.WriteString(fmt.Sprintf("%sHas\n", indentStr))
+	}
+	sb.WriteString(fmt.Sprintf("%sValue:\n%s", strings.Repeat("  ", indent+1), e.Value.String(indent+2)))
+	sb.WriteString(fmt.Sprintf("%sInterface:\n%s", strings.Repeat("  ", indent+1), e.Interface.String(indent+2)))
+	return sb.String()
+}
@@ -0,0 +1,41 @@
+package expression
+
+import (
+	"fmt"
+	"strings"
+	"zen/lang/common"
+	"zen/lang/parsing/ast"
+)
+
+// IsTypeExpression tests whether a value is of a type, e.g. shape is Drawable
+// Objects are instances of their class, its superclasses and the interfaces they declare to implement
+type IsTypeExpression struct {
+	Value    ast.Expression
+	Type     ast.Expression
+	Location *common.SourceLocation
+}
+
+func NewIsTypeExpression(value ast.Expression, typ ast.Expression, location *common.SourceLocation) *IsTypeExpression {
+	return &IsTypeExpression{
+		Value:    value,
+		Type:     typ,
+		Location: location,
+	}
+}
+
+func (e *IsTypeExpression) Accept(visitor ast.Visitor) interface{} {
+	return visitor.VisitIs(e)
+}
+
+func (e *IsTypeExpression) GetLocation() *common.SourceLocation {
+	return e.Location
+}
+
+func (e *IsTypeExpression) IsExpression() {}
+
+func (e *IsTypeExpression) String(indent int) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%sIs %s\n", strings.Repeat("  ", indent), e.Type.String(0)))
+	sb.WriteString(e.Value.String(indent + 1))
+	return sb.String()
+}
@@ -0,0 +1,57 @@
+package expression
+
+import (
+	"strings"
+	"zen/lang/common"
+	"zen/lang/parsing/ast"
+)
+
+// LambdaExpression represents an anonymous function in the AST
+// Syntax:
+//
+//	{ x, y -> x * y }
+//	{ -> count += 1 }
+//
+// Parameter types are optional, untyped parameters accept any value.
+type LambdaExpression struct {
+	Parameters []FuncParameterExpression
+	Body       []ast.Statement
+	Location   *common.SourceLocation
+}
+
+func NewLambdaExpression(parameters []FuncParameterExpression, body []ast.Statement, location *common.SourceLocation) *LambdaExpression {
+	return &LambdaExpression{
+		Parameters: parameters,
+		Body:       body,
+		Location:   location,
+	}
+}
+
+func (e *LambdaExpression) Accept(visitor ast.Visitor) interface{} {
+	return visitor.VisitLambda(e)
+}
+
+func (e *LambdaExpression) GetLocation() *common.SourceLocation {
+	return e.Location
+}
+
+func (e *LambdaExpression) IsExpression() {}
+
+func (e *LambdaExpression) String(indent int) string {
+	var sb strings.Builder
+	indentStr := strings.Repeat("  ", indent)
+
+	sb.WriteString(indentStr + "Lambda\n")
+
+	sb.WriteString(indentStr + "  Parameters:\n")
+	for _, p := range e.Parameters {
+		sb.WriteString(p.String(indent + 1))
+	}
+
+	sb.WriteString(indentStr + "  Body:\n")
+	for _, stmt := range e.Body {
+		sb.WriteString(stmt.String(indent + 2))
+	}
+
+	return sb.String()
+}
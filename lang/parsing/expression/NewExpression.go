@@ -0,0 +1,52 @@
+package expression
+
+import (
+	"fmt"
+	"strings"
+	"zen/lang/common"
+	"zen/lang/parsing/ast"
+)
+
+// NewExpression represents the creation of a class instance with the 'new' keyword, e.g. new Person("john")
+// The parentheses are optional when there are no arguments: new Person
+type NewExpression struct {
+	Class     ast.Expression
+	Arguments []ast.Expression
+	// TypeArguments are the type arguments of a generic class, e.g. int in new Box<int>(1)
+	TypeArguments []Parameter
+	Location      *common.SourceLocation
+}
+
+func NewNewExpression(class ast.Expression, arguments []ast.Expression, location *common.SourceLocation) *NewExpression {
+	return &NewExpression{
+		Class:     class,
+		Arguments: arguments,
+		Location:  location,
+	}
+}
+
+func (e *NewExpression) Accept(visitor ast.Visitor) interface{} {
+	return visitor.VisitNew(e)
+}
+
+func (e *NewExpression) GetLocation() *common.SourceLocation {
+	return e.Location
+}
+
+func (e *NewExpression) IsExpression() {}
+
+func (e *NewExpression) String(indent int) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%sNew\n", strings.Repeat("  ", indent)))
+	sb.WriteString(fmt.Sprintf("%sClass:\n%s", strings.Repeat("  ", indent+1), e.Class.String(indent+2)))
+	if len(e.TypeArguments) > 0 {
+		sb.WriteString(fmt.Sprintf("%sTypeArguments: %s\n", strings.Repeat("  ", indent+1), FormatTypeArguments(e.TypeArguments)))
+	}
+	if len(e.Arguments) > 0 {
+		sb.WriteString(fmt.Sprintf("%sArguments:\n", strings.Repeat("  ", indent+1)))
+		for _, arg := range e.Arguments {
+			sb.WriteString(arg.String(indent + 2))
+		}
+	}
+	return sb.String()
+}
@@ -12,10 +12,12 @@ type Parameter struct {
 	// If IsType is true, Value is either:
 	// - a string (type name)
 	// - a BasicType
-	// - a ParametricType
+	// - a ParametricType, FunctionType or UnionType
 	// If IsType is false, Value is an int64 (size/range constraint)
-	Value    interface{}
-	IsType   bool
+	Value  interface{}
+	IsType bool
+	// Nullable is true for nullable types, e.g. int? in Array<int?>
+	Nullable bool
 	Location *common.SourceLocation
 }
 
@@ -47,22 +49,25 @@ func (p *ParametricType) GetLocation() *common.SourceLocation {
 func (p *ParametricType) IsExpression() {}
 
 func (p *ParametricType) String(indent int) string {
-	params := make([]string, len(p.Parameters))
-	for i, param := range p.Parameters {
-		if param.IsType {
-			switch v := param.Value.(type) {
-			case string:
-				params[i] = v
-			case *BasicType:
-				params[i] = v.Name
-			case *ParametricType:
-				params[i] = v.String(0) // Don't indent nested types
-			default:
-				params[i] = fmt.Sprintf("<%T>", v) // For debugging
-			}
-		} else {
-			params[i] = fmt.Sprintf("%d", param.Value.(int64))
-		}
+	return strings.Repeat("  ", indent) + fmt.Sprintf("%s<%s>", p.BaseType, FormatTypeArguments(p.Parameters))
+}
+
+// String returns the parameter as it is written, e.g. int, 5, int? or Array<int>
+func (p Parameter) String() string {
+	if !p.IsType {
+		return fmt.Sprintf("%d", p.Value.(int64))
+	}
+	var name string
+	switch v := p.Value.(type) {
+	case string:
+		name = v
+	case ast.Expression:
+		name = v.String(0) // Don't indent nested types
+	default:
+		return fmt.Sprintf("<%T>", v) // For debugging
+	}
+	if p.Nullable {
+		return name + "?"
 	}
-	return strings.Repeat("  ", indent) + fmt.Sprintf("%s<%s>", p.BaseType, strings.Join(params, ", "))
+	return name
 }
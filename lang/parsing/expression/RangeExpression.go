@@ -0,0 +1,43 @@
+package expression
+
+import (
+	"fmt"
+	"strings"
+	"zen/lang/common"
+	"zen/lang/parsing/ast"
+)
+
+// RangeExpression represents a range of integers in the AST (e.g., 3..5)
+// The start is inclusive and the end is exclusive
+type RangeExpression struct {
+	Start    ast.Expression
+	End      ast.Expression
+	Location *common.SourceLocation
+}
+
+func NewRangeExpression(start ast.Expression, end ast.Expression, location *common.SourceLocation) *RangeExpression {
+	return &RangeExpression{
+		Start:    start,
+		End:      end,
+		Location: location,
+	}
+}
+
+func (e *RangeExpression) Accept(visitor ast.Visitor) interface{} {
+	return visitor.VisitRange(e)
+}
+
+func (e *RangeExpression) GetLocation() *common.SourceLocation {
+	return e.Location
+}
+
+func (e *RangeExpression) IsExpression() {}
+
+func (e *RangeExpression) String(indent int) string {
+	return fmt.Sprintf("%sRange:\n%sStart:\n%s%sEnd:\n%s",
+		strings.Repeat("  ", indent),
+		strings.Repeat("  ", indent+1),
+		e.Start.String(indent+2),
+		strings.Repeat("  ", indent+1),
+		e.End.String(indent+2))
+}
@@ -0,0 +1,35 @@
+package expression
+
+import (
+	"fmt"
+	"strings"
+	"zen/lang/common"
+	"zen/lang/parsing/ast"
+)
+
+// SpawnExpression represents a function call run in parallel on its own isolate, e.g. spawn worker(jobs, 5)
+type SpawnExpression struct {
+	Call     *CallExpression
+	Location *common.SourceLocation
+}
+
+func NewSpawnExpression(call *CallExpression, location *common.SourceLocation) *SpawnExpression {
+	return &SpawnExpression{
+		Call:     call,
+		Location: location,
+	}
+}
+
+func (e *SpawnExpression) Accept(visitor ast.Visitor) interface{} {
+	return visitor.VisitSpawn(e)
+}
+
+func (e *SpawnExpression) GetLocation() *common.SourceLocation {
+	return e.Location
+}
+
+func (e *SpawnExpression) IsExpression() {}
+
+func (e *SpawnExpression) String(indent int) string {
+	return fmt.Sprintf("%sSpawn:\n%s", strings.Repeat("  ", indent), e.Call.String(indent+1))
+}
@@ -0,0 +1,40 @@
+package expression
+
+import (
+	"fmt"
+	"strings"
+	"zen/lang/common"
+	"zen/lang/parsing/ast"
+)
+
+// TupleExpression represents the comma separated values returned by a function returning multiple values,
+// e.g. 404, "not found" in return 404, "not found"
+type TupleExpression struct {
+	Elements []ast.Expression
+	Location *common.SourceLocation
+}
+
+func NewTupleExpression(elements []ast.Expression, location *common.SourceLocation) *TupleExpression {
+	return &TupleExpression{
+		Elements: elements,
+		Location: location,
+	}
+}
+
+func (e *TupleExpression) Accept(visitor ast.Visitor) interface{} {
+	return visitor.VisitTuple(e)
+}
+
+func (e *TupleExpression) GetLocation() *common.SourceLocation {
+	return e.Location
+}
+
+func (e *TupleExpression) IsExpression() {}
+
+func (e *TupleExpression) String(indent int) string {
+	var elements []string
+	for _, element := range e.Elements {
+		elements = append(elements, element.String(indent+1))
+	}
+	return fmt.Sprintf("%sTuple:\n%s", strings.Repeat("  ", indent), strings.Join(elements, ""))
+}
@@ -0,0 +1,49 @@
+package expression
+
+import (
+	"strings"
+	"zen/lang/common"
+	"zen/lang/parsing/ast"
+)
+
+// TupleType represents the named return types of a function returning multiple values,
+// e.g. statusCode: int, body: string in func httpRequest(): statusCode: int, body: string
+type TupleType struct {
+	Elements []*TupleTypeElement
+	Location *common.SourceLocation
+}
+
+// TupleTypeElement is a single named and typed element of a TupleType, e.g. body: string?
+type TupleTypeElement struct {
+	Name       string
+	Type       ast.Expression
+	IsNullable bool
+}
+
+func NewTupleType(elements []*TupleTypeElement, location *common.SourceLocation) *TupleType {
+	return &TupleType{
+		Elements: elements,
+		Location: location,
+	}
+}
+
+func (t *TupleType) Accept(visitor ast.Visitor) interface{} {
+	return visitor.VisitTupleType(t)
+}
+
+func (t *TupleType) GetLocation() *common.SourceLocation {
+	return t.Location
+}
+
+func (t *TupleType) IsExpression() {}
+
+func (t *TupleType) String(indent int) string {
+	elements := make([]string, len(t.Elements))
+	for i, element := range t.Elements {
+		elements[i] = element.Name + ": " + element.Type.String(0)
+		if element.IsNullable {
+			elements[i] += "?"
+		}
+	}
+	return strings.Repeat("  ", indent) + strings.Join(elements, ", ")
+}
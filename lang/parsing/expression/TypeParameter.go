@@ -0,0 +1,52 @@
+package expression
+
+import (
+	"strings"
+	"zen/lang/common"
+	"zen/lang/parsing/ast"
+)
+
+// TypeParameter is a parameter of a generic class or function, e.g. T in class Box<T>.
+// Value parameters are declared with a type, e.g. N in class Grid<T, N: int>, and take an integer instead of a type.
+// Parameters declared without a type take either, like S in Map<K, V, S>
+type TypeParameter struct {
+	Name string
+	// Type is the type of a value parameter, or nil
+	Type     ast.Expression
+	Location *common.SourceLocation
+}
+
+// NewTypeParameter creates a new TypeParameter
+func NewTypeParameter(name string, typ ast.Expression, location *common.SourceLocation) *TypeParameter {
+	return &TypeParameter{
+		Name:     name,
+		Type:     typ,
+		Location: location,
+	}
+}
+
+// String returns the parameter as it is declared, e.g. T or N: int
+func (p *TypeParameter) String() string {
+	if p.Type != nil {
+		return p.Name + ": " + p.Type.String(0)
+	}
+	return p.Name
+}
+
+// FormatTypeParameters returns type parameters as they are declared, e.g. T, N: int
+func FormatTypeParameters(params []*TypeParameter) string {
+	names := make([]string, len(params))
+	for i, param := range params {
+		names[i] = param.String()
+	}
+	return strings.Join(names, ", ")
+}
+
+// FormatTypeArguments returns the type arguments of a parametric type or generic call, e.g. int, 3
+func FormatTypeArguments(args []Parameter) string {
+	names := make([]string, len(args))
+	for i, arg := range args {
+		names[i] = arg.String()
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,38 @@
+package expression
+
+import (
+	"strings"
+	"zen/lang/common"
+	"zen/lang/parsing/ast"
+)
+
+// UnionType represents a type that can be any one of several types, e.g. string | int
+type UnionType struct {
+	Types    []ast.Expression
+	Location *common.SourceLocation
+}
+
+func NewUnionType(types []ast.Expression, location *common.SourceLocation) *UnionType {
+	return &UnionType{
+		Types:    types,
+		Location: location,
+	}
+}
+
+func (t *UnionType) Accept(visitor ast.Visitor) interface{} {
+	return visitor.VisitUnionType(t)
+}
+
+func (t *UnionType) GetLocation() *common.SourceLocation {
+	return t.Location
+}
+
+func (t *UnionType) IsExpression() {}
+
+func (t *UnionType) String(indent int) string {
+	types := make([]string, len(t.Types))
+	for i, typ := range t.Types {
+		types[i] = typ.String(0)
+	}
+	return strings.Repeat("  ", indent) + strings.Join(types, " | ")
+}
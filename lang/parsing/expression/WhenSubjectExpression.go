@@ -0,0 +1,33 @@
+package expression
+
+import (
+	"strings"
+	"zen/lang/common"
+	"zen/lang/parsing/ast"
+)
+
+// WhenSubjectExpression represents the value being matched by a when statement.
+// It is the implicit receiver of a leading-dot predicate such as `.length > 5`
+type WhenSubjectExpression struct {
+	Location *common.SourceLocation
+}
+
+func NewWhenSubjectExpression(location *common.SourceLocation) *WhenSubjectExpression {
+	return &WhenSubjectExpression{
+		Location: location,
+	}
+}
+
+func (e *WhenSubjectExpression) Accept(visitor ast.Visitor) interface{} {
+	return visitor.VisitWhenSubject(e)
+}
+
+func (e *WhenSubjectExpression) GetLocation() *common.SourceLocation {
+	return e.Location
+}
+
+func (e *WhenSubjectExpression) IsExpression() {}
+
+func (e *WhenSubjectExpression) String(indent int) string {
+	return strings.Repeat("  ", indent) + "WhenSubject\n"
+}
@@ -0,0 +1,44 @@
+package expression
+
+import (
+	"fmt"
+	"strings"
+	"zen/lang/common"
+	"zen/lang/parsing/ast"
+)
+
+// WhereExpression represents a collection filter in the AST (e.g., people where person{"age"} > 20)
+// The condition refers to each element through an otherwise undefined identifier, or through 'value',
+// and to its position through 'index' (arrays) or 'key' (maps), or is a lambda naming them, e.g. { person -> person{"age"} > 20 }
+type WhereExpression struct {
+	Collection ast.Expression
+	Condition  ast.Expression
+	Location   *common.SourceLocation
+}
+
+func NewWhereExpression(collection ast.Expression, condition ast.Expression, location *common.SourceLocation) *WhereExpression {
+	return &WhereExpression{
+		Collection: collection,
+		Condition:  condition,
+		Location:   location,
+	}
+}
+
+func (e *WhereExpression) Accept(visitor ast.Visitor) interface{} {
+	return visitor.VisitWhere(e)
+}
+
+func (e *WhereExpression) GetLocation() *common.SourceLocation {
+	return e.Location
+}
+
+func (e *WhereExpression) IsExpression() {}
+
+func (e *WhereExpression) String(indent int) string {
+	return fmt.Sprintf("%sWhere:\n%sCollection:\n%s%sCondition:\n%s",
+		strings.Repeat("  ", indent),
+		strings.Repeat("  ", indent+1),
+		e.Collection.String(indent+2),
+		strings.Repeat("  ", indent+1),
+		e.Condition.String(indent+2))
+}
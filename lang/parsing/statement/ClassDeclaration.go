@@ -0,0 +1,125 @@
+package statement
+
+import (
+	"strings"
+	"zen/lang/common"
+	"zen/lang/parsing/ast"
+	"zen/lang/parsing/expression"
+)
+
+// ClassDeclaration represents a class in the AST
+// Syntax:
+//
+//	class Name extends Superclass implements Interface, ... {
+//	    use Trait(args) { member as alias }
+//	    field: type = default
+//	    func init(params) { body }
+//	    func method(params): type { body }
+//	    include("_methods.zen")
+//	}
+type ClassDeclaration struct {
+	Name string
+	// TypeParameters of a generic class, e.g. T in class Box<T>
+	TypeParameters []*expression.TypeParameter
+	// Superclass is the type of the class being extended, e.g. Animal or Array<int>, or nil
+	Superclass ast.Expression
+	// Interfaces are the types of the interfaces the class implements, e.g. Drawable
+	Interfaces []ast.Expression
+	// Uses are the traits the class is composed of, in declaration order
+	Uses []*TraitUse
+	// Fields in declaration order, which is also the parameter order of the inferred constructor
+	Fields []*VarDeclarationNode
+	// Methods in declaration order, including any number of init constructors
+	Methods []*FuncDeclaration
+	// Includes are the files declaring further members of the class, see ClassInclude
+	Includes []*ClassInclude
+	// Visibility of the top-level declaration
+	Visibility ast.Visibility
+	Location   *common.SourceLocation
+}
+
+func NewClassDeclaration(name string, superclass ast.Expression, interfaces []ast.Expression, uses []*TraitUse, fields []*VarDeclarationNode, methods []*FuncDeclaration, location *common.SourceLocation) *ClassDeclaration {
+	return &ClassDeclaration{
+		Name:       name,
+		Superclass: superclass,
+		Interfaces: interfaces,
+		Uses:       uses,
+		Fields:     fields,
+		Methods:    methods,
+		Location:   location,
+	}
+}
+
+func (c *ClassDeclaration) Accept(visitor ast.Visitor) interface{} {
+	return visitor.VisitClassDeclaration(c)
+}
+
+func (c *ClassDeclaration) GetLocation() *common.SourceLocation {
+	return c.Location
+}
+
+func (c *ClassDeclaration) IsStatement() {}
+
+func (c *ClassDeclaration) String(indent int) string {
+	var builder strings.Builder
+	indentStr := strings.Repeat("  ", indent)
+
+	builder.WriteString(indentStr + "Class " + c.Name + "\n")
+	if c.Visibility != ast.Public {
+		builder.WriteString(indentStr + "  Visibility: " + c.Visibility.String() + "\n")
+	}
+
+	if len(c.TypeParameters) > 0 {
+		builder.WriteString(indentStr + "  TypeParameters: " + expression.FormatTypeParameters(c.TypeParameters) + "\n")
+	}
+
+	if c.Superclass != nil {
+		builder.WriteString(indentStr + "  Extends: " + c.Superclass.String(0) + "\n")
+	}
+
+	if len(c.Interfaces) > 0 {
+		names := make([]string, len(c.Interfaces))
+		for i, iface := range c.Interfaces {
+			names[i] = iface.String(0)
+		}
+		builder.WriteString(indentStr + "  Implements: " + strings.Join(names, ", ") + "\n")
+	}
+
+	if len(c.Uses) > 0 {
+		builder.WriteString(indentStr + "  Uses:\n")
+		for _, use := range c.Uses {
+			builder.WriteString(use.String(indent + 2))
+		}
+	}
+
+	builder.WriteString(indentStr + "  Fields:\n")
+	for _, field := range c.Fields {
+		builder.WriteString(field.String(indent + 2))
+	}
+
+	builder.WriteString(indentStr + "  Methods:\n")
+	for _, method := range c.Methods {
+		builder.WriteString(method.String(indent + 2))
+	}
+
+	for _, include := range c.Includes {
+		builder.WriteString(indentStr + "  Include: " + include.Path + "\n")
+	}
+
+	return builder.String()
+}
+
+// ClassInclude is a call to include in a class body, whose file declares further members of the class.
+// The path is relative to the file declaring the class
+// Syntax: include("path")
+type ClassInclude struct {
+	Path     string
+	Location *common.SourceLocation
+}
+
+func NewClassInclude(path string, location *common.SourceLocation) *ClassInclude {
+	return &ClassInclude{
+		Path:     path,
+		Location: location,
+	}
+}
@@ -0,0 +1,42 @@
+package statement
+
+import (
+	"strings"
+	"zen/lang/common"
+	"zen/lang/parsing/ast"
+	"zen/lang/parsing/expression"
+)
+
+// DeferStatement represents a call made when the enclosing function exits, or the program for top-level defers
+// Syntax: defer call
+type DeferStatement struct {
+	Location *common.SourceLocation
+	Call     *expression.CallExpression
+}
+
+func NewDeferStatement(call *expression.CallExpression, location *common.SourceLocation) *DeferStatement {
+	return &DeferStatement{
+		Location: location,
+		Call:     call,
+	}
+}
+
+func (s *DeferStatement) Accept(visitor ast.Visitor) interface{} {
+	return visitor.VisitDeferStatement(s)
+}
+
+func (s *DeferStatement) GetLocation() *common.SourceLocation {
+	return s.Location
+}
+
+func (s *DeferStatement) IsStatement() {}
+
+func (s *DeferStatement) String(indent int) string {
+	var sb strings.Builder
+	indentStr := strings.Repeat("  ", indent)
+
+	sb.WriteString(indentStr + "Defer\n")
+	sb.WriteString(s.Call.String(indent + 1))
+
+	return sb.String()
+}
@@ -0,0 +1,57 @@
+package statement
+
+import (
+	"strings"
+	"zen/lang/common"
+	"zen/lang/parsing/ast"
+)
+
+// DestructuringDeclaration declares a variable or constant for each element of a tuple, in order
+//
+//	var statusCode, body <= httpRequest()
+type DestructuringDeclaration struct {
+	// Names of the declared variables. Elements unpacked into _ are skipped
+	Names       []string
+	Initializer ast.Expression
+	IsConstant  bool
+	// Visibility of the top-level variables
+	Visibility ast.Visibility
+	Location   *common.SourceLocation
+}
+
+func NewDestructuringDeclaration(names []string, initializer ast.Expression, isConstant bool, location *common.SourceLocation) *DestructuringDeclaration {
+	return &DestructuringDeclaration{
+		Names:       names,
+		Initializer: initializer,
+		IsConstant:  isConstant,
+		Location:    location,
+	}
+}
+
+func (n *DestructuringDeclaration) Accept(visitor ast.Visitor) interface{} {
+	return visitor.VisitDestructuringDeclaration(n)
+}
+
+func (n *DestructuringDeclaration) GetLocation() *common.SourceLocation {
+	return n.Location
+}
+
+func (n *DestructuringDeclaration) IsStatement() {}
+
+func (n *DestructuringDeclaration) String(indent int) string {
+	var sb strings.Builder
+	indentStr := strings.Repeat("  ", indent)
+
+	if n.IsConstant {
+		sb.WriteString(indentStr + "Const Destructuring\n")
+	} else {
+		sb.WriteString(indentStr + "Var Destructuring\n")
+	}
+	sb.WriteString(indentStr + "  Names: " + strings.Join(n.Names, ", ") + "\n")
+	if n.Visibility != ast.Public {
+		sb.WriteString(indentStr + "  Visibility: " + n.Visibility.String() + "\n")
+	}
+	sb.WriteString(indentStr + "  Initializer:\n")
+	sb.WriteString(n.Initializer.String(indent+2) + "\n")
+	return sb.String()
+}
@@ -11,11 +11,13 @@ import (
 //
 //	for key, value in map { body }
 //	for value in map { body }
+//	for value in array where condition { body }
 type ForInStatement struct {
 	Location  *common.SourceLocation
 	Key       string         // Optional key variable (can be empty)
 	Value     string         // Value variable
 	Container ast.Expression // Expression being iterated over (e.g., map, array)
+	Condition ast.Expression // Optional where-clause, iterations where it is false are skipped (can be nil)
 	Body      []ast.Statement
 }
 
@@ -23,6 +25,7 @@ func NewForInStatement(
 	key string,
 	value string,
 	container ast.Expression,
+	condition ast.Expression,
 	body []ast.Statement,
 	location *common.SourceLocation,
 ) *ForInStatement {
@@ -30,6 +33,7 @@ func NewForInStatement(
 		Key:       key,
 		Value:     value,
 		Container: container,
+		Condition: condition,
 		Body:      body,
 		Location:  location,
 	}
@@ -58,6 +62,10 @@ func (s *ForInStatement) String(indent int) string {
 
 	sb.WriteString(indentStr + "  Container:\n")
 	sb.WriteString(s.Container.String(indent + 2))
+	if s.Condition != nil {
+		sb.WriteString(indentStr + "  Where:\n")
+		sb.WriteString(s.Condition.String(indent + 2))
+	}
 
 	sb.WriteString(indentStr + "  Body:\n")
 	for _, stmt := range s.Body {
@@ -8,11 +8,16 @@ import (
 )
 
 type FuncDeclaration struct {
-	Name       string
-	Parameters []expression.FuncParameterExpression
-	ReturnType ast.Expression
-	Body       []ast.Statement
-	Async      bool
+	Name string
+	// TypeParameters of a generic function, e.g. T in func first<T>(xs: Array<T>): T
+	TypeParameters []*expression.TypeParameter
+	Parameters     []expression.FuncParameterExpression
+	ReturnType     ast.Expression
+	// Body is nil for the method signatures of interfaces
+	Body  []ast.Statement
+	Async bool
+	// Visibility of a method or top-level function
+	Visibility ast.Visibility
 	location   *common.SourceLocation
 }
 
@@ -45,6 +50,13 @@ func (n *FuncDeclaration) String(indent int) string {
 
 	// Write name
 	sb.WriteString(indentStr + "FuncDeclaration " + n.Name + "\n")
+	if n.Visibility != ast.Public {
+		sb.WriteString(indentStr + "  Visibility: " + n.Visibility.String() + "\n")
+	}
+
+	if len(n.TypeParameters) > 0 {
+		sb.WriteString(indentStr + "  TypeParameters: " + expression.FormatTypeParameters(n.TypeParameters) + "\n")
+	}
 
 	// Write parameters
 	sb.WriteString(indentStr + "  Parameters:\n")
@@ -55,6 +67,11 @@ func (n *FuncDeclaration) String(indent int) string {
 	// Write return type
 	sb.WriteString(indentStr + "  ReturnType: " + n.ReturnType.String(indent+1) + "\n")
 
+	// Methods declared by interfaces have no body
+	if n.Body == nil {
+		return sb.String()
+	}
+
 	// Write body
 	sb.WriteString(indentStr + "  Body:\n")
 	for _, stmt := range n.Body {
@@ -0,0 +1,72 @@
+package statement
+
+import (
+	"strings"
+	"zen/lang/common"
+	"zen/lang/parsing/ast"
+)
+
+// ImportStatement imports a module or the modules of a namespace, or selected symbols of them
+// Syntax: import Package/Namespace/Module [as alias]
+// Syntax: from Package/Namespace/Module import symbol [as alias], ...
+type ImportStatement struct {
+	// Path of the imported module or namespace, starting with the package name, e.g. GameEngine, Core, Vector2
+	Path []string
+	// Alias the module or namespace is imported as, empty to import its symbols directly
+	Alias string
+	// Symbols selected by a from import, nil for import statements
+	Symbols  []*ImportedSymbol
+	Location *common.SourceLocation
+}
+
+// ImportedSymbol is a symbol selected by a from import, e.g. hello or hello as hi
+type ImportedSymbol struct {
+	Name string
+	// Alias the symbol is imported as, empty to import it under its own name
+	Alias    string
+	Location *common.SourceLocation
+}
+
+func NewImportStatement(path []string, alias string, symbols []*ImportedSymbol, location *common.SourceLocation) *ImportStatement {
+	return &ImportStatement{
+		Path:     path,
+		Alias:    alias,
+		Symbols:  symbols,
+		Location: location,
+	}
+}
+
+// PathString returns the path as it is written in zen code, e.g. GameEngine/Core/Vector2
+func (s *ImportStatement) PathString() string {
+	return strings.Join(s.Path, "/")
+}
+
+func (s *ImportStatement) Accept(visitor ast.Visitor) interface{} {
+	return visitor.VisitImportStatement(s)
+}
+
+func (s *ImportStatement) GetLocation() *common.SourceLocation {
+	return s.Location
+}
+
+func (s *ImportStatement) IsStatement() {}
+
+func (s *ImportStatement) String(indent int) string {
+	var sb strings.Builder
+	indentStr := strings.Repeat("  ", indent)
+
+	sb.WriteString(indentStr + "Import " + s.PathString())
+	if s.Alias != "" {
+		sb.WriteString(" as " + s.Alias)
+	}
+	sb.WriteString("\n")
+
+	for _, symbol := range s.Symbols {
+		sb.WriteString(indentStr + "  Symbol " + symbol.Name)
+		if symbol.Alias != "" {
+			sb.WriteString(" as " + symbol.Alias)
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
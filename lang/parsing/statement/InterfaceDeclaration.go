@@ -0,0 +1,73 @@
+package statement
+
+import (
+	"strings"
+	"zen/lang/common"
+	"zen/lang/parsing/ast"
+	"zen/lang/parsing/expression"
+)
+
+// InterfaceDeclaration represents an interface in the AST
+// Syntax:
+//
+//	interface Name[<T, ...>] {
+//	    func method(params): type
+//	    var property: type
+//	}
+type InterfaceDeclaration struct {
+	Name string
+	// TypeParameters of a generic interface, e.g. K, V in interface BracketGet<K, V>
+	TypeParameters []*expression.TypeParameter
+	// Properties in declaration order, without initializers
+	Properties []*VarDeclarationNode
+	// Methods in declaration order, without bodies
+	Methods []*FuncDeclaration
+	// Visibility of the top-level declaration
+	Visibility ast.Visibility
+	Location   *common.SourceLocation
+}
+
+func NewInterfaceDeclaration(name string, properties []*VarDeclarationNode, methods []*FuncDeclaration, location *common.SourceLocation) *InterfaceDeclaration {
+	return &InterfaceDeclaration{
+		Name:       name,
+		Properties: properties,
+		Methods:    methods,
+		Location:   location,
+	}
+}
+
+func (d *InterfaceDeclaration) Accept(visitor ast.Visitor) interface{} {
+	return visitor.VisitInterfaceDeclaration(d)
+}
+
+func (d *InterfaceDeclaration) GetLocation() *common.SourceLocation {
+	return d.Location
+}
+
+func (d *InterfaceDeclaration) IsStatement() {}
+
+func (d *InterfaceDeclaration) String(indent int) string {
+	var builder strings.Builder
+	indentStr := strings.Repeat("  ", indent)
+
+	builder.WriteString(indentStr + "Interface " + d.Name + "\n")
+	if d.Visibility != ast.Public {
+		builder.WriteString(indentStr + "  Visibility: " + d.Visibility.String() + "\n")
+	}
+
+	if len(d.TypeParameters) > 0 {
+		builder.WriteString(indentStr + "  TypeParameters: " + expression.FormatTypeParameters(d.TypeParameters) + "\n")
+	}
+
+	builder.WriteString(indentStr + "  Properties:\n")
+	for _, property := range d.Properties {
+		builder.WriteString(property.String(indent + 2))
+	}
+
+	builder.WriteString(indentStr + "  Methods:\n")
+	for _, method := range d.Methods {
+		builder.WriteString(method.String(indent + 2))
+	}
+
+	return builder.String()
+}
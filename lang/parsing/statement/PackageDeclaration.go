@@ -0,0 +1,35 @@
+package statement
+
+import (
+	"strings"
+	"zen/lang/common"
+	"zen/lang/parsing/ast"
+)
+
+// PackageDeclaration names the root namespace of a package, declared in the package.zen file at its root
+// Syntax: package Name
+type PackageDeclaration struct {
+	Name     string
+	Location *common.SourceLocation
+}
+
+func NewPackageDeclaration(name string, location *common.SourceLocation) *PackageDeclaration {
+	return &PackageDeclaration{
+		Name:     name,
+		Location: location,
+	}
+}
+
+func (d *PackageDeclaration) Accept(visitor ast.Visitor) interface{} {
+	return visitor.VisitPackageDeclaration(d)
+}
+
+func (d *PackageDeclaration) GetLocation() *common.SourceLocation {
+	return d.Location
+}
+
+func (d *PackageDeclaration) IsStatement() {}
+
+func (d *PackageDeclaration) String(indent int) string {
+	return strings.Repeat("  ", indent) + "Package " + d.Name + "\n"
+}
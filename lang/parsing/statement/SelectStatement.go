@@ -0,0 +1,111 @@
+package statement
+
+import (
+	"strings"
+	"zen/lang/common"
+	"zen/lang/parsing/ast"
+)
+
+// SelectStatement waits until a send or receive of one of its arms can proceed, performs it and executes the body of that arm.
+// With an else arm, the statement doesn't wait: the else body is executed if no arm can proceed right away.
+// Syntax:
+//
+//	select {
+//	    results.receive() as result { body }
+//	    jobs.send(job) { body }
+//	    else { body }
+//	}
+type SelectStatement struct {
+	Location *common.SourceLocation
+	Arms     []*SelectArm
+	// HasElse is true if the statement ends with an else arm, whose body is Else
+	HasElse bool
+	Else    []ast.Statement
+}
+
+func NewSelectStatement(arms []*SelectArm, hasElse bool, elseBody []ast.Statement, location *common.SourceLocation) *SelectStatement {
+	return &SelectStatement{
+		Location: location,
+		Arms:     arms,
+		HasElse:  hasElse,
+		Else:     elseBody,
+	}
+}
+
+func (s *SelectStatement) Accept(visitor ast.Visitor) interface{} {
+	return visitor.VisitSelectStatement(s)
+}
+
+func (s *SelectStatement) GetLocation() *common.SourceLocation {
+	return s.Location
+}
+
+func (s *SelectStatement) IsStatement() {}
+
+func (s *SelectStatement) String(indent int) string {
+	var builder strings.Builder
+	indentStr := strings.Repeat("  ", indent)
+
+	builder.WriteString(indentStr + "Select\n")
+	for _, arm := range s.Arms {
+		builder.WriteString(arm.String(indent + 1))
+	}
+
+	if s.HasElse {
+		builder.WriteString(indentStr + "  Else:\n")
+		for _, stmt := range s.Else {
+			builder.WriteString(stmt.String(indent + 2))
+		}
+	}
+
+	return builder.String()
+}
+
+// SelectArm represents: channel.send(value) { [body] } or channel.receive() [as name] { [body] }
+type SelectArm struct {
+	Location *common.SourceLocation
+	Channel  ast.Expression
+	// Send is true for arms sending Value, and false for arms receiving a value
+	Send  bool
+	Value ast.Expression
+	// Alias is the name the received value is bound to in the body, or empty
+	Alias string
+	Body  []ast.Statement
+}
+
+func NewSelectArm(channel ast.Expression, send bool, value ast.Expression, alias string, body []ast.Statement, location *common.SourceLocation) *SelectArm {
+	return &SelectArm{
+		Location: location,
+		Channel:  channel,
+		Send:     send,
+		Value:    value,
+		Alias:    alias,
+		Body:     body,
+	}
+}
+
+func (a *SelectArm) String(indent int) string {
+	var builder strings.Builder
+	indentStr := strings.Repeat("  ", indent)
+
+	if a.Send {
+		builder.WriteString(indentStr + "Send:\n")
+	} else if a.Alias != "" {
+		builder.WriteString(indentStr + "Receive as " + a.Alias + ":\n")
+	} else {
+		builder.WriteString(indentStr + "Receive:\n")
+	}
+	builder.WriteString(indentStr + "  Channel:\n")
+	builder.WriteString(a.Channel.String(indent + 2))
+	if a.Send {
+		builder.WriteString(indentStr + "  Value:\n")
+		builder.WriteString(a.Value.String(indent + 2))
+	}
+
+	builder.WriteString(indentStr + "  Body:\n")
+	for _, stmt := range a.Body {
+		builder.WriteString(stmt.String(indent + 2))
+	}
+
+	return builder.String()
+}
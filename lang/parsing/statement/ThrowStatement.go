@@ -0,0 +1,41 @@
+package statement
+
+import (
+	"strings"
+	"zen/lang/common"
+	"zen/lang/parsing/ast"
+)
+
+// ThrowStatement represents throwing an exception
+// Syntax: throw expression
+type ThrowStatement struct {
+	Location   *common.SourceLocation
+	Expression ast.Expression
+}
+
+func NewThrowStatement(expression ast.Expression, location *common.SourceLocation) *ThrowStatement {
+	return &ThrowStatement{
+		Location:   location,
+		Expression: expression,
+	}
+}
+
+func (s *ThrowStatement) Accept(visitor ast.Visitor) interface{} {
+	return visitor.VisitThrowStatement(s)
+}
+
+func (s *ThrowStatement) GetLocation() *common.SourceLocation {
+	return s.Location
+}
+
+func (s *ThrowStatement) IsStatement() {}
+
+func (s *ThrowStatement) String(indent int) string {
+	var sb strings.Builder
+	indentStr := strings.Repeat("  ", indent)
+
+	sb.WriteString(indentStr + "Throw\n")
+	sb.WriteString(s.Expression.String(indent + 1))
+
+	return sb.String()
+}
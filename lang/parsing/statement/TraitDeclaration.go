@@ -0,0 +1,113 @@
+package statement
+
+import (
+	"strings"
+	"zen/lang/common"
+	"zen/lang/parsing/ast"
+)
+
+// TraitDeclaration represents a trait in the AST, a set of fields and methods classes can be composed of
+// Syntax:
+//
+//	trait Name {
+//	    field: type = default
+//	    func init(params) { body }
+//	    func method(params): type { body }
+//	}
+type TraitDeclaration struct {
+	Name string
+	// Fields in declaration order
+	Fields []*VarDeclarationNode
+	// Methods in declaration order, including the optional init called when an object of a class using the trait is created
+	Methods []*FuncDeclaration
+	// Visibility of the top-level declaration
+	Visibility ast.Visibility
+	Location   *common.SourceLocation
+}
+
+func NewTraitDeclaration(name string, fields []*VarDeclarationNode, methods []*FuncDeclaration, location *common.SourceLocation) *TraitDeclaration {
+	return &TraitDeclaration{
+		Name:     name,
+		Fields:   fields,
+		Methods:  methods,
+		Location: location,
+	}
+}
+
+func (d *TraitDeclaration) Accept(visitor ast.Visitor) interface{} {
+	return visitor.VisitTraitDeclaration(d)
+}
+
+func (d *TraitDeclaration) GetLocation() *common.SourceLocation {
+	return d.Location
+}
+
+func (d *TraitDeclaration) IsStatement() {}
+
+func (d *TraitDeclaration) String(indent int) string {
+	var builder strings.Builder
+	indentStr := strings.Repeat("  ", indent)
+
+	builder.WriteString(indentStr + "Trait " + d.Name + "\n")
+	if d.Visibility != ast.Public {
+		builder.WriteString(indentStr + "  Visibility: " + d.Visibility.String() + "\n")
+	}
+
+	builder.WriteString(indentStr + "  Fields:\n")
+	for _, field := range d.Fields {
+		builder.WriteString(field.String(indent + 2))
+	}
+
+	builder.WriteString(indentStr + "  Methods:\n")
+	for _, method := range d.Methods {
+		builder.WriteString(method.String(indent + 2))
+	}
+
+	return builder.String()
+}
+
+// TraitUse is the use of a trait in a class body, with the arguments passed to the trait's init
+// and the members renamed in the class
+// Syntax: use Name(args) { member as alias ... }
+type TraitUse struct {
+	Trait     ast.Expression
+	Arguments []ast.Expression
+	Aliases   []*TraitAlias
+	Location  *common.SourceLocation
+}
+
+// TraitAlias renames a member of a trait in the class using it, e.g. serializableFields as _serializable
+type TraitAlias struct {
+	Member   string
+	Alias    string
+	Location *common.SourceLocation
+}
+
+func NewTraitUse(trait ast.Expression, arguments []ast.Expression, aliases []*TraitAlias, location *common.SourceLocation) *TraitUse {
+	return &TraitUse{
+		Trait:     trait,
+		Arguments: arguments,
+		Aliases:   aliases,
+		Location:  location,
+	}
+}
+
+func (u *TraitUse) String(indent int) string {
+	var builder strings.Builder
+	indentStr := strings.Repeat("  ", indent)
+
+	builder.WriteString(indentStr + "Use " + u.Trait.String(0) + "\n")
+
+	if len(u.Arguments) > 0 {
+		builder.WriteString(indentStr + "  Arguments:\n")
+		for _, arg := range u.Arguments {
+			builder.WriteString(arg.String(indent + 2))
+		}
+	}
+
+	for _, alias := range u.Aliases {
+		builder.WriteString(indentStr + "  " + alias.Member + " as " + alias.Alias + "\n")
+	}
+
+	return builder.String()
+}
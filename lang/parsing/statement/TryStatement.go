@@ -0,0 +1,83 @@
+package statement
+
+import (
+	"strings"
+	"zen/lang/common"
+	"zen/lang/parsing/ast"
+)
+
+// TryStatement represents a try block with its catch clauses and finally block
+// Syntax: try { body } catch e: Type { handler } catch e { handler } finally { cleanup }
+type TryStatement struct {
+	Location *common.SourceLocation
+	Body     []ast.Statement
+	// Catches are tried in order, the first one whose type matches the exception handles it
+	Catches []*CatchClause
+	// Finally runs after the body and the handling catch clause however they exit, nil if there is no finally block
+	Finally []ast.Statement
+}
+
+// CatchClause handles the exceptions of a type thrown in the body of a try statement
+type CatchClause struct {
+	// Name the exception is bound to in the body of the clause
+	Name string
+	// Type of the exceptions the clause catches, nil to catch any exception
+	Type     ast.Expression
+	Body     []ast.Statement
+	Location *common.SourceLocation
+}
+
+func NewTryStatement(
+	body []ast.Statement,
+	catches []*CatchClause,
+	finally []ast.Statement,
+	location *common.SourceLocation,
+) *TryStatement {
+	return &TryStatement{
+		Body:     body,
+		Catches:  catches,
+		Finally:  finally,
+		Location: location,
+	}
+}
+
+func (s *TryStatement) Accept(visitor ast.Visitor) interface{} {
+	return visitor.VisitTryStatement(s)
+}
+
+func (s *TryStatement) GetLocation() *common.SourceLocation {
+	return s.Location
+}
+
+func (s *TryStatement) IsStatement() {}
+
+func (s *TryStatement) String(indent int) string {
+	var sb strings.Builder
+	indentStr := strings.Repeat("  ", indent)
+
+	sb.WriteString(indentStr + "TryStatement\n")
+	sb.WriteString(indentStr + "  Body:\n")
+	for _, stmt := range s.Body {
+		sb.WriteString(stmt.String(indent + 2))
+	}
+
+	for _, catch := range s.Catches {
+		if catch.Type != nil {
+			sb.WriteString(indentStr + "  Catch " + catch.Name + ": " + catch.Type.String(0) + "\n")
+		} else {
+			sb.WriteString(indentStr + "  Catch " + catch.Name + "\n")
+		}
+		for _, stmt := range catch.Body {
+			sb.WriteString(stmt.String(indent + 2))
+		}
+	}
+
+	if s.Finally != nil {
+		sb.WriteString(indentStr + "  Finally:\n")
+		for _, stmt := range s.Finally {
+			sb.WriteString(stmt.String(indent + 2))
+		}
+	}
+
+	return sb.String()
+}
@@ -15,7 +15,9 @@ type VarDeclarationNode struct {
 	Initializer ast.Expression
 	IsConstant  bool
 	IsNullable  bool
-	Location    *common.SourceLocation
+	// Visibility of a class field or top-level variable
+	Visibility ast.Visibility
+	Location   *common.SourceLocation
 }
 
 // NewVarDeclarationNode creates a new VarDeclarationNode instance.
@@ -61,6 +63,9 @@ func (n *VarDeclarationNode) String(indent int) string {
 
 	// Write name and type
 	sb.WriteString(fmt.Sprintf("%s  Name: %s\n", indentStr, n.Name))
+	if n.Visibility != ast.Public {
+		sb.WriteString(fmt.Sprintf("%s  Visibility: %s\n", indentStr, n.Visibility))
+	}
 	if n.Type != nil {
 		sb.WriteString(fmt.Sprintf("%s  Type:\n", indentStr))
 		sb.WriteString(n.Type.String(indent+2) + "\n")
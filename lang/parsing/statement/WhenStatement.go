@@ -0,0 +1,144 @@
+package statement
+
+import (
+	"strings"
+	"zen/lang/common"
+	"zen/lang/parsing/ast"
+)
+
+// WhenStatement matches a value against a list of arms and executes the body of the first arm that matches
+// Syntax:
+//
+//	when subject {
+//	    "john" or "jane" { body }
+//	    0..12 { body }
+//	    is int { body }
+//	    .length > 5 { body }
+//	    else { body }
+//	}
+type WhenStatement struct {
+	Location *common.SourceLocation
+	Subject  ast.Expression
+	Arms     []*WhenArm
+	// HasElse is true if the statement ends with an else arm, whose body is Else
+	HasElse bool
+	Else    []ast.Statement
+}
+
+func NewWhenStatement(subject ast.Expression, arms []*WhenArm, hasElse bool, elseBody []ast.Statement, location *common.SourceLocation) *WhenStatement {
+	return &WhenStatement{
+		Location: location,
+		Subject:  subject,
+		Arms:     arms,
+		HasElse:  hasElse,
+		Else:     elseBody,
+	}
+}
+
+func (s *WhenStatement) Accept(visitor ast.Visitor) interface{} {
+	return visitor.VisitWhenStatement(s)
+}
+
+func (s *WhenStatement) GetLocation() *common.SourceLocation {
+	return s.Location
+}
+
+func (s *WhenStatement) IsStatement() {}
+
+func (s *WhenStatement) String(indent int) string {
+	var builder strings.Builder
+	indentStr := strings.Repeat("  ", indent)
+
+	builder.WriteString(indentStr + "When\n")
+	builder.WriteString(indentStr + "  Subject:\n")
+	builder.WriteString(s.Subject.String(indent + 2))
+
+	for _, arm := range s.Arms {
+		builder.WriteString(arm.String(indent + 1))
+	}
+
+	if s.HasElse {
+		builder.WriteString(indentStr + "  Else:\n")
+		for _, stmt := range s.Else {
+			builder.WriteString(stmt.String(indent + 2))
+		}
+	}
+
+	return builder.String()
+}
+
+// WhenArm represents: [pattern] or [pattern] { [body] }
+// The arm matches if any of its patterns match the subject of the when statement
+type WhenArm struct {
+	Location *common.SourceLocation
+	Patterns []*WhenPattern
+	Body     []ast.Statement
+}
+
+func NewWhenArm(patterns []*WhenPattern, body []ast.Statement, location *common.SourceLocation) *WhenArm {
+	return &WhenArm{
+		Location: location,
+		Patterns: patterns,
+		Body:     body,
+	}
+}
+
+func (a *WhenArm) String(indent int) string {
+	var builder strings.Builder
+	indentStr := strings.Repeat("  ", indent)
+
+	builder.WriteString(indentStr + "Arm:\n")
+	for _, pattern := range a.Patterns {
+		builder.WriteString(pattern.String(indent + 1))
+	}
+
+	builder.WriteString(indentStr + "  Body:\n")
+	for _, stmt := range a.Body {
+		builder.WriteString(stmt.String(indent + 2))
+	}
+
+	return builder.String()
+}
+
+// WhenPatternKind tells how a WhenPattern is matched against the subject
+type WhenPatternKind int
+
+const (
+	// WhenValue matches a subject equal to the pattern's value, or included in it if the value is a range
+	WhenValue WhenPatternKind = iota
+	// WhenType matches a subject of the pattern's type, e.g. is int
+	WhenType
+	// WhenPredicate matches if the pattern's boolean expression, which refers to the subject with a leading dot, is true
+	WhenPredicate
+)
+
+// WhenPattern is a single pattern of a when arm
+type WhenPattern struct {
+	Location *common.SourceLocation
+	Kind     WhenPatternKind
+	// Value is the value or predicate of a WhenValue or WhenPredicate pattern
+	Value ast.Expression
+	// Type is the type of a WhenType pattern
+	Type ast.Expression
+}
+
+func NewWhenPattern(kind WhenPatternKind, value ast.Expression, typ ast.Expression, location *common.SourceLocation) *WhenPattern {
+	return &WhenPattern{
+		Location: location,
+		Kind:     kind,
+		Value:    value,
+		Type:     typ,
+	}
+}
+
+func (p *WhenPattern) String(indent int) string {
+	indentStr := strings.Repeat("  ", indent)
+	switch p.Kind {
+	case WhenType:
+		return indentStr + "Is: " + p.Type.String(0) + "\n"
+	case WhenPredicate:
+		return indentStr + "Predicate:\n" + p.Value.String(indent+1)
+	default:
+		return indentStr + "Value:\n" + p.Value.String(indent+1)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"zen/lang/common"
 	"zen/lang/lexing"
 	"zen/lang/parsing"
+	"zen/runtime/errors"
 )
 
 var DEBUG bool
@@ -123,7 +124,19 @@ func execute(code common.SourceCode) {
 	} else {
 		// execute
 		i := interpreter.NewInterpreter()
-		if err := i.Execute(program); err != nil {
+		err := i.Execute(program)
+		for _, warning := range i.Warnings() {
+			fmt.Fprintln(os.Stderr, warning)
+		}
+		switch e := err.(type) {
+		case *errors.Exception:
+			fmt.Printf("Uncaught %s: %s\n", e.Object.TypeName(), e.Message())
+			printStackTrace(e.Stack)
+		case *interpreter.RuntimeError:
+			fmt.Println("Interpreter error:", err)
+			printStackTrace(e.Stack)
+		case nil:
+		default:
 			fmt.Println("Interpreter error:", err)
 		}
 	}
@@ -140,6 +153,13 @@ func printSyntaxErrors(errors []*common.SyntaxError) {
 	}
 }
 
+// printStackTrace prints the function calls an error was raised in, innermost first,
+// each followed by the line of code it was executing with a marker pointing to the column.
+// Repeated calls of a recursive function are collapsed, see errors.FormatStack
+func printStackTrace(stack []*errors.StackFrame) {
+	fmt.Print(errors.FormatStack(stack, true))
+}
+
 // startREPL initializes and starts a Read-Eval-Print Loop (REPL) environment allowing interactive code execution.
 func startREPL() {
 	fmt.Println("Zen REPL:")
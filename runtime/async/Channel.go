@@ -0,0 +1,261 @@
+package async
+
+import (
+	"errors"
+	"fmt"
+	"zen/runtime"
+	"zen/runtime/types"
+)
+
+// ErrClosedChannel is the error sending on a closed channel fails with
+var ErrClosedChannel = errors.New("Cannot send on a closed channel")
+
+// Channel passes values between isolates, e.g. Channel<int>. Sending blocks until a receiver takes the value,
+// or, for buffered channels, until there's room in the buffer. Receiving blocks until a value is sent or the channel is closed.
+// Only shareable values can be sent, see types.IsShareable
+type Channel struct {
+	group    *Group
+	element  *types.TypeHint
+	capacity int
+	buffer   []types.Value
+	closed   bool
+	// receivers and senders are the blocked operations on the channel, in the order they started waiting
+	receivers []*pendingCase
+	senders   []*pendingCase
+}
+
+// pendingCase is a case of a blocked Select
+type pendingCase struct {
+	waiter *waiter
+	index  int
+}
+
+// NewChannel creates a channel of values of the given type, buffering up to capacity values, and shared by the isolates of the loop's group
+func (l *EventLoop) NewChannel(element *types.TypeHint, capacity int) *Channel {
+	return &Channel{
+		group:    l.group,
+		element:  element,
+		capacity: capacity,
+	}
+}
+
+// SelectCase is a send or receive on a channel, see Select
+type SelectCase struct {
+	Channel *Channel
+	// Send is true for sending Value, and false for receiving
+	Send  bool
+	Value types.Value
+}
+
+// Select performs the first of the cases that can proceed, or blocks until one of them can if block is true.
+// Returns the index of the case performed, or -1 if none can proceed without blocking,
+// along with the value received and whether it was sent rather than the null received from a closed channel
+func Select(cases []SelectCase, block bool) (int, types.Value, bool, error) {
+	if len(cases) == 0 {
+		return -1, nil, false, errors.New("Cannot select without any channel operation")
+	}
+	for idx := range cases {
+		if cases[idx].Send {
+			value, err := cases[idx].Channel.check(cases[idx].Value)
+			if err != nil {
+				return -1, nil, false, err
+			}
+			cases[idx].Value = value
+		}
+	}
+
+	group := cases[0].Channel.group
+	group.mu.Lock()
+	for idx, c := range cases {
+		if c.Send {
+			if c.Channel.closed {
+				group.mu.Unlock()
+				return -1, nil, false, ErrClosedChannel
+			}
+			if c.Channel.trySend(c.Value) {
+				group.mu.Unlock()
+				return idx, nil, true, nil
+			}
+		} else if value, ok, ready := c.Channel.tryReceive(); ready {
+			group.mu.Unlock()
+			return idx, value, ok, nil
+		}
+	}
+	if !block {
+		group.mu.Unlock()
+		return -1, nil, false, nil
+	}
+
+	w := newWaiter(cases)
+	for idx, c := range cases {
+		pending := &pendingCase{waiter: w, index: idx}
+		if c.Send {
+			c.Channel.senders = append(c.Channel.senders, pending)
+		} else {
+			c.Channel.receivers = append(c.Channel.receivers, pending)
+		}
+	}
+	group.block(w)
+	group.mu.Unlock()
+
+	<-w.wake
+	return w.index, w.value, w.ok, w.err
+}
+
+// Send sends a value on the channel, blocking until it's received or buffered
+func (c *Channel) Send(value types.Value) error {
+	_, _, _, err := Select([]SelectCase{{Channel: c, Send: true, Value: value}}, true)
+	return err
+}
+
+// Receive receives a value from the channel, blocking until one is sent.
+// Returns null and false once the channel is closed and its buffer is empty
+func (c *Channel) Receive() (types.Value, bool, error) {
+	_, value, ok, err := Select([]SelectCase{{Channel: c}}, true)
+	return value, ok, err
+}
+
+// Close closes the channel: receivers get the values left in the buffer and then null, and sending fails.
+// Senders blocked on the channel fail with ErrClosedChannel
+func (c *Channel) Close() error {
+	c.group.mu.Lock()
+	defer c.group.mu.Unlock()
+	if c.closed {
+		return errors.New("Cannot close a channel that is already closed")
+	}
+	c.closed = true
+
+	for receiver := c.next(&c.receivers); receiver != nil; receiver = c.next(&c.receivers) {
+		c.group.complete(receiver.waiter, receiver.index, types.NewNull(), false, nil)
+	}
+	for sender := c.next(&c.senders); sender != nil; sender = c.next(&c.senders) {
+		c.group.complete(sender.waiter, sender.index, nil, false, ErrClosedChannel)
+	}
+	return nil
+}
+
+// check converts a value to the element type of the channel, and verifies it can be shared with other isolates
+func (c *Channel) check(value types.Value) (types.Value, error) {
+	converted, err := c.element.Check(value)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot send on %s: %s", c.TypeHint(), err)
+	}
+	if !types.IsShareable(converted) {
+		return nil, fmt.Errorf("Cannot send %s on %s, only immutable values and channels can be shared between isolates", converted.Type(), c.TypeHint())
+	}
+	return converted.Clone(), nil
+}
+
+// trySend hands a value to a blocked receiver or buffers it, and returns false if neither is possible. The mutex must be held
+func (c *Channel) trySend(value types.Value) bool {
+	if receiver := c.next(&c.receivers); receiver != nil {
+		c.group.complete(receiver.waiter, receiver.index, value, true, nil)
+		return true
+	}
+	if len(c.buffer) < c.capacity {
+		c.buffer = append(c.buffer, value)
+		return true
+	}
+	return false
+}
+
+// tryReceive takes a value from the buffer or a blocked sender, or null once the channel is closed.
+// Returns false for ready if the receive has to block. The mutex must be held
+func (c *Channel) tryReceive() (value types.Value, ok bool, ready bool) {
+	if len(c.buffer) > 0 {
+		value = c.buffer[0]
+		c.buffer = c.buffer[1:]
+		// Make room for the value of the first blocked sender
+		if sender := c.next(&c.senders); sender != nil {
+			c.buffer = append(c.buffer, sender.waiter.cases[sender.index].Value)
+			c.group.complete(sender.waiter, sender.index, nil, true, nil)
+		}
+		return value, true, true
+	}
+	if sender := c.next(&c.senders); sender != nil {
+		value = sender.waiter.cases[sender.index].Value
+		c.group.complete(sender.waiter, sender.index, nil, true, nil)
+		return value, true, true
+	}
+	if c.closed {
+		return types.NewNull(), false, true
+	}
+	return nil, false, false
+}
+
+// next removes the first operation still blocked from a queue and returns it, or nil if there is none.
+// Operations of a Select that already completed another case are dropped. The mutex must be held
+func (c *Channel) next(queue *[]*pendingCase) *pendingCase {
+	for len(*queue) > 0 {
+		pending := (*queue)[0]
+		*queue = (*queue)[1:]
+		if !pending.waiter.done {
+			return pending
+		}
+	}
+	return nil
+}
+
+// TypeHint returns the type of the channel, e.g. Channel<int>
+func (c *Channel) TypeHint() *types.TypeHint {
+	hint := types.NewTypeHint("Channel", false)
+	hint.Arguments = []*types.TypeHint{c.element}
+	return hint
+}
+
+// GetMember returns the methods and properties of the channel:
+// send(value), receive(), close(), and the number of buffered values, its capacity and whether it's closed
+func (c *Channel) GetMember(name string) (types.Value, error) {
+	switch name {
+	case "send":
+		return c.method(name, []*types.FunctionParameterHint{
+			types.NewFunctionParameterHint("value", types.TypeAny, true),
+		}, func(args map[string]types.Value) (types.Value, error) {
+			return nil, c.Send(args["value"])
+		}), nil
+	case "receive":
+		return c.method(name, nil, func(args map[string]types.Value) (types.Value, error) {
+			value, _, err := c.Receive()
+			return value, err
+		}), nil
+	case "close":
+		return c.method(name, nil, func(args map[string]types.Value) (types.Value, error) {
+			return nil, c.Close()
+		}), nil
+	case "length":
+		c.group.mu.Lock()
+		defer c.group.mu.Unlock()
+		return types.NewInt(int32(len(c.buffer))), nil
+	case "capacity":
+		return types.NewInt(int32(c.capacity)), nil
+	case "closed":
+		c.group.mu.Lock()
+		defer c.group.mu.Unlock()
+		return types.NewBool(c.closed), nil
+	}
+	return nil, types.NewTypeError("Channel has no member '%s'", name)
+}
+
+// method creates a built-in function bound to the channel
+func (c *Channel) method(name string, params []*types.FunctionParameterHint, fn func(args map[string]types.Value) (types.Value, error)) *types.BuiltinFunction {
+	return types.NewBuiltinFunction(name, params, nil, false, func(_ *runtime.EnvironmentInterface, args map[string]types.Value) (types.Value, error) {
+		return fn(args)
+	})
+}
+
+func (c *Channel) Type() types.Type { return types.TypeChannel }
+func (c *Channel) IsTruthy() bool   { return true }
+
+// Clone returns the channel itself, since it's shared by everything referring to it
+func (c *Channel) Clone() types.Value { return c }
+
+// IsShareable returns true, channels are how isolates communicate
+func (c *Channel) IsShareable() bool { return true }
+
+func (c *Channel) Equals(other types.Value) bool {
+	return c == other
+}
+
+func (c *Channel) String() string {
+	return "<" + c.TypeHint().String() + ">"
+}
@@ -0,0 +1,56 @@
+package async
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock tells the time of the event loop and waits for timers to become due, see EventLoop.SetClock
+type Clock interface {
+	// Now returns the current time
+	Now() time.Time
+	// After returns a channel receiving the current time once the given duration has passed
+	After(duration time.Duration) <-chan time.Time
+}
+
+// RealClock is the wall clock, used by default
+type RealClock struct{}
+
+func (RealClock) Now() time.Time                                { return time.Now() }
+func (RealClock) After(duration time.Duration) <-chan time.Time { return time.After(duration) }
+
+// VirtualClock is a clock whose time only moves forward when it's told to,
+// so timers fire right away in the order they are due, without actually waiting.
+// It's safe to share between the event loops of isolates
+type VirtualClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewVirtualClock creates a virtual clock starting at the Unix epoch
+func NewVirtualClock() *VirtualClock {
+	return &VirtualClock{now: time.Unix(0, 0)}
+}
+
+func (c *VirtualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// After advances the time of the clock instead of waiting, see Advance, and returns a channel that already received the new time
+func (c *VirtualClock) After(duration time.Duration) <-chan time.Time {
+	c.Advance(duration)
+	ch := make(chan time.Time, 1)
+	ch <- c.Now()
+	return ch
+}
+
+// Advance moves the time of the clock forward by the given duration
+func (c *VirtualClock) Advance(duration time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if duration > 0 {
+		c.now = c.now.Add(duration)
+	}
+}
@@ -0,0 +1,266 @@
+package async
+
+import (
+	"errors"
+)
+
+// ErrStalled is the error a task awaiting a promise is resumed with when the event loop runs out of work
+// while the promise is still pending, so the promise can never settle
+var ErrStalled = errors.New("awaited promise never settles")
+
+// ErrAborted is the error tasks awaiting a promise are resumed with when the event loop stops early, see Run
+var ErrAborted = errors.New("event loop aborted")
+
+// EventLoop runs tasks on a single thread of execution.
+// Tasks run until they suspend, e.g. to await a promise, and are resumed by microtasks,
+// which run one at a time in the order they were enqueued, so the order tasks interleave in is deterministic.
+// Once no microtasks are left, the loop waits for the next timer to become due and runs its callback.
+// Each isolate has its own event loop, and other isolates report to it through Post, see Group
+type EventLoop struct {
+	microtasks []func()
+	// timers that haven't fired or been cancelled, in the order they are due, see Timer
+	timers     []*Timer
+	timerCount int
+	clock      Clock
+	// tasks that were started and haven't completed, in the order they were started
+	tasks []*Task
+	// rejected promises, in the order they were rejected, see Run
+	rejected []*Promise
+	// aborted is true once the loop stopped early, see abort
+	aborted bool
+
+	group *Group
+	// posted are the callbacks other goroutines posted that haven't run yet, guarded by the mutex of the group
+	posted []func()
+	// expected is the number of callbacks the loop waits for other goroutines to post, see Expect
+	expected int
+	// wake is signalled when a callback is posted while the loop waits for a timer
+	wake chan struct{}
+	// idle is set while the loop has nothing left to do but wait for a posted callback
+	idle *waiter
+}
+
+// NewEventLoop creates the event loop of the main isolate of a program without any work, whose timers use the wall clock
+func NewEventLoop() *EventLoop {
+	return &EventLoop{
+		clock: RealClock{},
+		group: newGroup(),
+		wake:  make(chan struct{}, 1),
+	}
+}
+
+// Fork creates the event loop of a new isolate of the same program, with the same clock.
+// The isolate is running until its loop exits, see Exit
+func (l *EventLoop) Fork() *EventLoop {
+	l.group.join()
+	return &EventLoop{
+		clock: l.clock,
+		group: l.group,
+		wake:  make(chan struct{}, 1),
+	}
+}
+
+// Exit marks the isolate of a loop created by Fork as completed
+func (l *EventLoop) Exit() {
+	l.group.leave()
+}
+
+// Expect keeps the loop running until another goroutine posts a callback, e.g. the result of a spawned function, see Post
+func (l *EventLoop) Expect() {
+	l.expected++
+}
+
+// Post schedules a callback the loop expects from another goroutine, see Expect. Safe to call from any goroutine
+func (l *EventLoop) Post(callback func()) {
+	l.group.mu.Lock()
+	defer l.group.mu.Unlock()
+	l.posted = append(l.posted, callback)
+	if l.idle != nil && !l.idle.done {
+		l.group.complete(l.idle, -1, nil, true, nil)
+		return
+	}
+	select {
+	case l.wake <- struct{}{}:
+	default:
+	}
+}
+
+// SetClock replaces the clock timers are scheduled with, e.g. with a VirtualClock in tests.
+// Must be called before any timer is created
+func (l *EventLoop) SetClock(clock Clock) {
+	l.clock = clock
+}
+
+// Clock returns the clock timers are scheduled with
+func (l *EventLoop) Clock() Clock {
+	return l.clock
+}
+
+// Enqueue schedules a microtask, which runs after the microtasks enqueued before it
+func (l *EventLoop) Enqueue(microtask func()) {
+	l.microtasks = append(l.microtasks, microtask)
+}
+
+// Start runs a new task until it first suspends or completes
+func (l *EventLoop) Start(task *Task) {
+	l.tasks = append(l.tasks, task)
+	task.continueWith(nil)
+}
+
+// Run starts the main task and runs microtasks until none are left, then fires the next timer, until no timers are left either.
+// The loop then waits for the callbacks it expects from other goroutines, see Expect.
+// Tasks still awaiting a promise by then are resumed with ErrStalled, oldest first, and the loop goes on until all tasks complete.
+// If all isolates are blocked meanwhile, the tasks are resumed with ErrDeadlock instead, see Group.
+// Returns the error of the first rejected promise no task awaited, if any.
+// Such a rejection stops the loop before the next timer fires, so a failing interval doesn't keep it running, see abort
+func (l *EventLoop) Run(main *Task) error {
+	l.Start(main)
+	for {
+		l.drain()
+		if l.runPosted() {
+			continue
+		}
+
+		if len(l.timers) > 0 {
+			if err := l.unhandled(); err != nil {
+				l.abort()
+				return err
+			}
+			l.fire(l.timers[0])
+			continue
+		}
+
+		if l.expected > 0 {
+			if err := l.waitPosted(); err != nil {
+				l.resumeStalled(err)
+			}
+			continue
+		}
+
+		if !l.resumeStalled(ErrStalled) {
+			break
+		}
+	}
+
+	return l.unhandled()
+}
+
+// resumeStalled resumes the tasks awaiting a promise with err, oldest first. Returns false if all tasks completed
+func (l *EventLoop) resumeStalled(err error) bool {
+	stalled := l.pending()
+	for _, task := range stalled {
+		if task.awaiting != nil {
+			task.awaiting = nil
+			task.continueWith(err)
+		}
+	}
+	return len(stalled) > 0
+}
+
+// runPosted runs the callbacks posted by other goroutines, and returns false if there were none
+func (l *EventLoop) runPosted() bool {
+	l.group.mu.Lock()
+	posted := l.posted
+	l.posted = nil
+	l.group.mu.Unlock()
+
+	for _, callback := range posted {
+		l.expected--
+		callback()
+	}
+	return len(posted) > 0
+}
+
+// waitPosted blocks the isolate until a callback is posted. Returns ErrDeadlock if all isolates are blocked
+func (l *EventLoop) waitPosted() error {
+	l.group.mu.Lock()
+	if len(l.posted) > 0 {
+		l.group.mu.Unlock()
+		return nil
+	}
+	w := newWaiter(nil)
+	l.idle = w
+	l.group.block(w)
+	l.group.mu.Unlock()
+
+	<-w.wake
+	l.group.mu.Lock()
+	l.idle = nil
+	l.group.mu.Unlock()
+	return w.err
+}
+
+// unhandled returns the error of the first rejected promise no task awaited, and forgets the rejected promises
+func (l *EventLoop) unhandled() error {
+	rejected := l.rejected
+	l.rejected = nil
+	for _, promise := range rejected {
+		if !promise.handled {
+			return promise.err
+		}
+	}
+	return nil
+}
+
+// abort stops the loop: timers are cancelled and tasks still awaiting a promise are resumed with ErrAborted,
+// as are tasks awaiting a promise from then on, so every task completes without waiting for anything
+func (l *EventLoop) abort() {
+	l.aborted = true
+	for _, timer := range l.timers {
+		timer.stopped = true
+	}
+	l.timers = nil
+
+	for _, task := range l.pending() {
+		if task.awaiting != nil {
+			task.awaiting = nil
+			task.continueWith(ErrAborted)
+		}
+	}
+	l.microtasks = nil
+}
+
+// fire waits until a timer is due and runs its callback. Intervals are scheduled again first, so the callback may cancel them.
+// While the loop expects callbacks from other goroutines, a posted callback interrupts the wait and the timer doesn't fire yet
+func (l *EventLoop) fire(timer *Timer) {
+	if wait := timer.due.Sub(l.clock.Now()); wait > 0 {
+		if l.expected > 0 {
+			select {
+			case <-l.wake:
+				return
+			case <-l.clock.After(wait):
+			}
+		} else {
+			<-l.clock.After(wait)
+		}
+	}
+
+	l.timers = l.timers[1:]
+	if timer.interval > 0 {
+		l.schedule(timer, timer.due.Add(timer.interval))
+	} else {
+		timer.stopped = true
+	}
+	timer.callback()
+}
+
+// drain runs microtasks until none are left, including the ones they enqueue
+func (l *EventLoop) drain() {
+	for len(l.microtasks) > 0 {
+		microtask := l.microtasks[0]
+		l.microtasks = l.microtasks[1:]
+		microtask()
+	}
+}
+
+// pending returns the tasks that haven't completed, forgetting the ones that have
+func (l *EventLoop) pending() []*Task {
+	tasks := l.tasks[:0]
+	for _, task := range l.tasks {
+		if !task.Done() {
+			tasks = append(tasks, task)
+		}
+	}
+	l.tasks = tasks
+	return append([]*Task{}, tasks...)
+}
@@ -0,0 +1,89 @@
+package async
+
+import (
+	"errors"
+	"sync"
+	"zen/runtime/types"
+)
+
+// ErrDeadlock is the error blocked isolates are woken with once every isolate is blocked, since none of them can go on
+var ErrDeadlock = errors.New("Deadlock, every isolate is blocked on a channel or waiting for another isolate")
+
+// Group coordinates the isolates of a program: the main program and the functions it spawns,
+// which each run on their own goroutine with their own event loop, see EventLoop.Fork.
+// Isolates only share channels, and report to each other through their event loops, see EventLoop.Post.
+// Once every running isolate is blocked, on a channel or waiting for another isolate, they are all woken with ErrDeadlock
+type Group struct {
+	mu      sync.Mutex
+	running int
+	blocked []*waiter
+}
+
+// newGroup creates the group of a program, whose main isolate is running
+func newGroup() *Group {
+	return &Group{running: 1}
+}
+
+// waiter is a blocked isolate, woken once the operation it's blocked on completes
+type waiter struct {
+	wake chan struct{}
+	done bool
+	// cases are the channel operations the isolate waits for, see Select
+	cases []SelectCase
+	// index is the case that completed, with the value it received and whether it was sent rather than the channel closed
+	index int
+	value types.Value
+	ok    bool
+	err   error
+}
+
+func newWaiter(cases []SelectCase) *waiter {
+	return &waiter{
+		wake:  make(chan struct{}, 1),
+		cases: cases,
+	}
+}
+
+// join adds an isolate to the group
+func (g *Group) join() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.running++
+}
+
+// leave removes an isolate that completed from the group, which may leave every remaining isolate blocked
+func (g *Group) leave() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.running--
+	g.checkDeadlock()
+}
+
+// block registers a waiter as blocked. The mutex must be held
+func (g *Group) block(w *waiter) {
+	g.blocked = append(g.blocked, w)
+	g.checkDeadlock()
+}
+
+// checkDeadlock wakes every blocked isolate with ErrDeadlock once all running isolates are blocked. The mutex must be held
+func (g *Group) checkDeadlock() {
+	if g.running == 0 || len(g.blocked) < g.running {
+		return
+	}
+	for _, w := range append([]*waiter{}, g.blocked...) {
+		g.complete(w, -1, nil, false, ErrDeadlock)
+	}
+}
+
+// complete wakes a blocked isolate with the outcome of the operation it waits for. The mutex must be held
+func (g *Group) complete(w *waiter, index int, value types.Value, ok bool, err error) {
+	w.done = true
+	w.index, w.value, w.ok, w.err = index, value, ok, err
+	for idx, blocked := range g.blocked {
+		if blocked == w {
+			g.blocked = append(g.blocked[:idx], g.blocked[idx+1:]...)
+			break
+		}
+	}
+	w.wake <- struct{}{}
+}
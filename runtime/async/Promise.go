@@ -0,0 +1,126 @@
+package async
+
+import (
+	"zen/runtime/types"
+)
+
+// PromiseState is the state of a Promise, which settles once: from Pending to either Fulfilled or Rejected
+type PromiseState int
+
+const (
+	Pending PromiseState = iota
+	Fulfilled
+	Rejected
+)
+
+// Promise is the eventual result of an asynchronous operation, such as a call to an async function.
+// Tasks awaiting a promise are resumed by microtasks once it settles, in the order they started awaiting it
+type Promise struct {
+	loop  *EventLoop
+	state PromiseState
+	value types.Value
+	err   error
+	// reactions run as microtasks once the promise settles
+	reactions []func()
+	// handled is true once a task awaits the promise, so its rejection isn't reported as unhandled
+	handled bool
+}
+
+// NewPromise creates a pending promise, whose reactions are run by the event loop
+func (l *EventLoop) NewPromise() *Promise {
+	return &Promise{loop: l}
+}
+
+// Resolved creates a promise fulfilled with the given value
+func (l *EventLoop) Resolved(value types.Value) *Promise {
+	promise := l.NewPromise()
+	promise.Resolve(value)
+	return promise
+}
+
+// Resolve fulfills the promise with a value. Resolving with another promise settles it like that promise once it settles.
+// Has no effect on a promise that is already settled
+func (p *Promise) Resolve(value types.Value) {
+	if other, ok := value.(*Promise); ok && other != p {
+		other.handled = true
+		other.then(func() {
+			if other.state == Rejected {
+				p.Reject(other.err)
+			} else {
+				p.Resolve(other.value)
+			}
+		})
+		return
+	}
+	p.settle(Fulfilled, value, nil)
+}
+
+// Reject settles the promise with an error, which is raised in the tasks awaiting it.
+// Has no effect on a promise that is already settled
+func (p *Promise) Reject(err error) {
+	p.settle(Rejected, nil, err)
+}
+
+// settle sets the outcome of a pending promise and schedules its reactions
+func (p *Promise) settle(state PromiseState, value types.Value, err error) {
+	if p.state != Pending {
+		return
+	}
+	p.state, p.value, p.err = state, value, err
+	if state == Rejected {
+		p.loop.rejected = append(p.loop.rejected, p)
+	}
+
+	for _, reaction := range p.reactions {
+		p.loop.Enqueue(reaction)
+	}
+	p.reactions = nil
+}
+
+// then runs a reaction as a microtask once the promise settles, or right away if it's settled already.
+// The promise is then handled, see EventLoop.Run
+func (p *Promise) then(reaction func()) {
+	p.handled = true
+	if p.state == Pending {
+		p.reactions = append(p.reactions, reaction)
+		return
+	}
+	p.loop.Enqueue(reaction)
+}
+
+// State returns whether the promise is pending, fulfilled or rejected
+func (p *Promise) State() PromiseState {
+	return p.state
+}
+
+// Value returns the value the promise is fulfilled with, or nil
+func (p *Promise) Value() types.Value {
+	return p.value
+}
+
+// Err returns the error the promise is rejected with, or nil
+func (p *Promise) Err() error {
+	return p.err
+}
+
+func (p *Promise) Type() types.Type { return types.TypePromise }
+func (p *Promise) IsTruthy() bool   { return true }
+
+// Clone returns the promise itself, since its outcome is shared by everything referring to it
+func (p *Promise) Clone() types.Value { return p }
+
+func (p *Promise) Equals(other types.Value) bool {
+	return p == other
+}
+
+// String returns the state of the promise, e.g. Promise { 5 } or Promise { <pending> }
+func (p *Promise) String() string {
+	switch p.state {
+	case Fulfilled:
+		return "Promise { " + types.Inspect(p.value) + " }"
+	case Rejected:
+		return "Promise { <rejected> " + p.err.Error() + " }"
+	default:
+		return "Promise { <pending> }"
+	}
+}
@@ -0,0 +1,89 @@
+package async
+
+import (
+	"zen/runtime/types"
+)
+
+// Task is a unit of work run by the event loop, such as the main program or a call to an async function.
+// Each task runs in its own goroutine, but only while it's resumed: control passes back and forth between
+// the task and whoever resumed it, so a single task executes at any time and a task can suspend halfway through,
+// e.g. to await a promise
+type Task struct {
+	// Name of the task, e.g. the name of the async function it calls
+	Name string
+	run  func(task *Task)
+	// resume passes control to the task, with the error its suspension ends with
+	resume chan error
+	// yield passes control back from the task once it suspends or completes
+	yield   chan struct{}
+	started bool
+	done    bool
+	// awaiting is the promise the task is suspended on, see Await
+	awaiting *Promise
+}
+
+// NewTask creates a task executing the given function once it's started by the event loop, see EventLoop.Start
+func NewTask(name string, run func(task *Task)) *Task {
+	return &Task{
+		Name:   name,
+		run:    run,
+		resume: make(chan error),
+		yield:  make(chan struct{}),
+	}
+}
+
+// Done returns true once the function of the task has returned
+func (t *Task) Done() bool {
+	return t.done
+}
+
+// Await suspends the task until the promise settles, and returns the value it's fulfilled with or the error it's rejected with.
+// The task is resumed by a microtask, even if the promise is already settled.
+// Returns ErrStalled if the event loop runs out of work while the promise is still pending,
+// and ErrAborted if the event loop stopped early, see EventLoop.Run
+func (t *Task) Await(promise *Promise) (types.Value, error) {
+	if promise.loop.aborted {
+		return nil, ErrAborted
+	}
+	t.awaiting = promise
+	promise.then(func() {
+		if t.awaiting == promise {
+			t.continueWith(nil)
+		}
+	})
+
+	err := t.suspend()
+	t.awaiting = nil
+	if err != nil {
+		return nil, err
+	}
+	if promise.state == Rejected {
+		return nil, promise.err
+	}
+	return promise.value, nil
+}
+
+// continueWith runs the task until it suspends or completes. err is returned by the suspension the task is resumed from
+func (t *Task) continueWith(err error) {
+	if t.done {
+		return
+	}
+	if !t.started {
+		t.started = true
+		go func() {
+			<-t.resume
+			t.run(t)
+			t.done = true
+			t.yield <- struct{}{}
+		}()
+	}
+	t.resume <- err
+	<-t.yield
+}
+
+// suspend passes control back to whoever resumed the task, and waits until the task is resumed.
+// Must be called from within the task
+func (t *Task) suspend() error {
+	t.yield <- struct{}{}
+	return <-t.resume
+}
@@ -0,0 +1,88 @@
+package async
+
+import (
+	"fmt"
+	"sort"
+	"time"
+	"zen/runtime/types"
+)
+
+// Timer runs a callback once its delay has passed, or repeatedly every interval, until it's cancelled.
+// Timers are handles in zen, returned by setTimeout and setInterval and passed to clearTimer
+type Timer struct {
+	// ID identifies the timer within its event loop, in the order timers were created
+	ID       int
+	loop     *EventLoop
+	due      time.Time
+	interval time.Duration
+	callback func()
+	stopped  bool
+}
+
+// SetTimeout schedules a callback to run once after the given delay
+func (l *EventLoop) SetTimeout(delay time.Duration, callback func()) *Timer {
+	return l.newTimer(delay, 0, callback)
+}
+
+// SetInterval schedules a callback to run every interval, until the returned timer is cancelled
+func (l *EventLoop) SetInterval(interval time.Duration, callback func()) *Timer {
+	return l.newTimer(interval, interval, callback)
+}
+
+// newTimer creates a timer due after delay, which repeats every interval if it isn't zero
+func (l *EventLoop) newTimer(delay time.Duration, interval time.Duration, callback func()) *Timer {
+	l.timerCount++
+	timer := &Timer{
+		ID:       l.timerCount,
+		loop:     l,
+		interval: interval,
+		callback: callback,
+	}
+	l.schedule(timer, l.clock.Now().Add(delay))
+	return timer
+}
+
+// schedule adds a timer to the timers of the loop, ordered by when they are due.
+// Timers due at the same time fire in the order they were scheduled
+func (l *EventLoop) schedule(timer *Timer, due time.Time) {
+	timer.due = due
+	idx := sort.Search(len(l.timers), func(idx int) bool {
+		return l.timers[idx].due.After(due)
+	})
+	l.timers = append(l.timers, nil)
+	copy(l.timers[idx+1:], l.timers[idx:])
+	l.timers[idx] = timer
+}
+
+// Cancel stops the timer, so its callback doesn't run anymore. Has no effect on a timer that is no longer active
+func (t *Timer) Cancel() {
+	if t.stopped {
+		return
+	}
+	t.stopped = true
+	for idx, timer := range t.loop.timers {
+		if timer == t {
+			t.loop.timers = append(t.loop.timers[:idx], t.loop.timers[idx+1:]...)
+			break
+		}
+	}
+}
+
+// Active returns true while the callback of the timer is scheduled to run, until it's cancelled or, unless it's an interval, has run
+func (t *Timer) Active() bool {
+	return !t.stopped
+}
+
+func (t *Timer) Type() types.Type { return types.TypeTimer }
+func (t *Timer) IsTruthy() bool   { return true }
+
+// Clone returns the timer itself, so cancelling a copy cancels the timer
+func (t *Timer) Clone() types.Value { return t }
+
+func (t *Timer) Equals(other types.Value) bool {
+	return t == other
+}
+
+func (t *Timer) String() string {
+	return fmt.Sprintf("<timer %d>", t.ID)
+}
@@ -2,8 +2,6 @@ package environment
 
 import (
 	"fmt"
-	"zen/builtins/global"
-	"zen/runtime/types"
 )
 
 // Environment manages the scope chain and provides high-level operations
@@ -41,6 +39,30 @@ func (e *Environment) EndScope() error {
 	return nil
 }
 
+// GlobalScope returns the root scope of the environment
+func (e *Environment) GlobalScope() *Scope {
+	return e.global
+}
+
+// CurrentScope returns the scope currently being executed
+func (e *Environment) CurrentScope() *Scope {
+	return e.current
+}
+
+// BeginScopeFrom creates a new scope with the given scope as its parent, and makes it the current scope.
+// This is used for function calls, whose scope does not descend from the caller's scope.
+// Returns the previous scope, which must be passed to RestoreScope when done
+func (e *Environment) BeginScopeFrom(parent *Scope) *Scope {
+	previous := e.current
+	e.current = NewScope(parent)
+	return previous
+}
+
+// RestoreScope makes the given scope the current scope again, see BeginScopeFrom
+func (e *Environment) RestoreScope(scope *Scope) {
+	e.current = scope
+}
+
 // Define creates a new variable in the current scope
 func (e *Environment) Define(name string, value interface{}) error {
 	return e.current.Define(name, value)
@@ -61,22 +83,28 @@ func (e *Environment) DefineGlobal(name string, value interface{}) error {
 	return e.global.Define(name, value)
 }
 
-func (e *Environment) RegisterBuiltInFunctions() {
-	printFn := types.BuiltinFunction{
-		Name: "print",
-		Parameters: []*types.FunctionParameterHint{
-			types.NewFunctionParameterHint("str", types.TypeString, false),
-		},
-		ReturnType: nil,
-		Async:      false,
-		Func:       global.Print,
-	}
+// SetTypeHint records the declared type of a variable in the current scope
+func (e *Environment) SetTypeHint(name string, hint interface{}) error {
+	return e.current.SetTypeHint(name, hint)
+}
 
-	e.global.Define("print", printFn)
+// SetPrivate marks a variable in the current scope as private
+func (e *Environment) SetPrivate(name string) error {
+	return e.current.SetPrivate(name)
+}
+
+// GetTypeHint returns the declared type of a variable in the current scope chain,
+// or nil if the variable doesn't exist or was declared without a type
+func (e *Environment) GetTypeHint(name string) interface{} {
+	info, err := e.current.GetInfo(name)
+	if err != nil {
+		return nil
+	}
+	return info.TypeHint()
 }
 
 // Get retrieves a variable's value from the current scope chain
-func (e *Environment) Get(name string) (types.Type, error) {
+func (e *Environment) Get(name string) (interface{}, error) {
 	return e.current.Get(name)
 }
 
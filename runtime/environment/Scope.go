@@ -5,6 +5,31 @@ type VarInfo struct {
 	value      interface{}
 	isConstant bool
 	isNullable bool
+	// typeHint is the declared type of the variable, or nil if it was declared without a type.
+	// It is opaque to the environment, the interpreter checks assigned values against it
+	typeHint interface{}
+	// isPrivate is true for private top-level declarations, which other modules can't import
+	isPrivate bool
+}
+
+// TypeHint returns the declared type of the variable, or nil if it was declared without a type
+func (v *VarInfo) TypeHint() interface{} {
+	return v.typeHint
+}
+
+// Value returns the value of the variable
+func (v *VarInfo) Value() interface{} {
+	return v.value
+}
+
+// IsConstant returns true if the variable was declared as a constant
+func (v *VarInfo) IsConstant() bool {
+	return v.isConstant
+}
+
+// IsPrivate returns true if the variable was declared private
+func (v *VarInfo) IsPrivate() bool {
+	return v.isPrivate
 }
 
 // Scope represents a single scope level in the environment chain
@@ -13,6 +38,8 @@ type Scope struct {
 	parent *Scope
 	// Variables stored in this scope
 	variables map[string]VarInfo
+	// names of the variables in the order they were defined, see Names
+	names []string
 }
 
 // NewScope creates a new scope with an optional parent
@@ -33,6 +60,7 @@ func (s *Scope) Define(name string, value interface{}) error {
 		isConstant: false,
 		isNullable: value == nil,
 	}
+	s.names = append(s.names, name)
 	return nil
 }
 
@@ -49,6 +77,7 @@ func (s *Scope) DefineConst(name string, value interface{}) error {
 		isConstant: true,
 		isNullable: false,
 	}
+	s.names = append(s.names, name)
 	return nil
 }
 
@@ -62,6 +91,29 @@ func (s *Scope) DefineNullable(name string, value interface{}) error {
 		isConstant: false,
 		isNullable: true,
 	}
+	s.names = append(s.names, name)
+	return nil
+}
+
+// SetTypeHint records the declared type of a variable defined in this scope
+func (s *Scope) SetTypeHint(name string, hint interface{}) error {
+	info, exists := s.variables[name]
+	if !exists {
+		return &UndefinedError{Name: name}
+	}
+	info.typeHint = hint
+	s.variables[name] = info
+	return nil
+}
+
+// SetPrivate marks a variable defined in this scope as private
+func (s *Scope) SetPrivate(name string) error {
+	info, exists := s.variables[name]
+	if !exists {
+		return &UndefinedError{Name: name}
+	}
+	info.isPrivate = true
+	s.variables[name] = info
 	return nil
 }
 
@@ -78,6 +130,18 @@ func (s *Scope) Get(name string) (interface{}, error) {
 	return nil, &UndefinedError{Name: name}
 }
 
+// Names returns the names of the variables defined in this scope, in the order they were defined.
+// Used to list the top-level declarations of a module
+func (s *Scope) Names() []string {
+	return append([]string{}, s.names...)
+}
+
+// GetLocal retrieves a variable's full info from this scope only, without looking at its parents
+func (s *Scope) GetLocal(name string) (*VarInfo, bool) {
+	info, exists := s.variables[name]
+	return &info, exists
+}
+
 // GetInfo retrieves a variable's full info from this scope or any parent scope
 func (s *Scope) GetInfo(name string) (*VarInfo, error) {
 	if info, exists := s.variables[name]; exists {
@@ -0,0 +1,67 @@
+package errors
+
+import (
+	"zen/runtime/environment"
+	"zen/runtime/types"
+)
+
+// Names of the built-in exception classes.
+// Errors raised by the interpreter itself, such as dividing by zero, are thrown as one of the runtime exceptions
+const (
+	// ExceptionClass is the base class of every exception, holding its message
+	ExceptionClass = "Exception"
+	// RuntimeExceptionClass is the base class of the exceptions raised by the interpreter
+	RuntimeExceptionClass = "RuntimeException"
+	// TypeExceptionClass is raised for values of the wrong type, e.g. assigning a string to an int variable
+	TypeExceptionClass = "TypeException"
+	// DivisionByZeroExceptionClass is raised for dividing by zero
+	DivisionByZeroExceptionClass = "DivisionByZeroException"
+	// UndefinedVariableExceptionClass is raised for reading a variable that isn't defined
+	UndefinedVariableExceptionClass = "UndefinedVariableException"
+	// IndexExceptionClass is raised for an index outside the elements of a collection, e.g. names[3] of an array of length 3
+	IndexExceptionClass = "IndexException"
+)
+
+// superclasses maps each built-in exception class to the class it extends, see DeclareExceptionClasses
+var superclasses = map[string]string{
+	RuntimeExceptionClass:           ExceptionClass,
+	TypeExceptionClass:              RuntimeExceptionClass,
+	DivisionByZeroExceptionClass:    RuntimeExceptionClass,
+	UndefinedVariableExceptionClass: RuntimeExceptionClass,
+	IndexExceptionClass:             RuntimeExceptionClass,
+}
+
+// DeclareExceptionClasses defines the built-in exception classes as constants in a scope, usually the global scope,
+// and returns them by name. Exception declares the message field, which is the only argument of the inferred
+// constructor of each exception class that doesn't declare fields of its own, e.g. new NotFoundException("no such user")
+func DeclareExceptionClasses(scope *environment.Scope) (map[string]*types.Class, error) {
+	exception := types.NewClass(ExceptionClass, scope)
+	exception.Fields = append(exception.Fields, &types.ClassField{
+		Name:    "message",
+		Type:    types.NewTypeHint("string", false),
+		Owner:   exception,
+		Closure: scope,
+	})
+	classes := map[string]*types.Class{ExceptionClass: exception}
+
+	var declare func(name string) *types.Class
+	declare = func(name string) *types.Class {
+		if class, ok := classes[name]; ok {
+			return class
+		}
+		class := types.NewClass(name, scope)
+		class.Superclass = declare(superclasses[name])
+		classes[name] = class
+		return class
+	}
+	for name := range superclasses {
+		declare(name)
+	}
+
+	for name, class := range classes {
+		if err := scope.DefineConst(name, class); err != nil {
+			return nil, err
+		}
+	}
+	return classes, nil
+}
@@ -0,0 +1,119 @@
+package errors
+
+import (
+	"fmt"
+	"strings"
+	"zen/lang/common"
+	"zen/runtime/types"
+)
+
+// Exception is a zen exception being thrown, an object of a class extending the built-in Exception class.
+// It unwinds execution up to the innermost try statement catching it, traveling through the interpreter as an error
+type Exception struct {
+	Object *types.Object
+	// Location of the throw statement, or of the error the interpreter raised
+	Location *common.SourceLocation
+	// Stack holds the active function calls at the time the exception was thrown, innermost first
+	Stack []*StackFrame
+}
+
+// StackFrame is a function call that was active when an exception was thrown
+type StackFrame struct {
+	// Function is the name of the function, or <main> for the top level of the program
+	Function string
+	// Location the function was executing at, the call to the next frame or the throw statement
+	Location *common.SourceLocation
+}
+
+// NewException creates an exception thrown at the given location
+func NewException(object *types.Object, location *common.SourceLocation, stack []*StackFrame) *Exception {
+	return &Exception{
+		Object:   object,
+		Location: location,
+		Stack:    stack,
+	}
+}
+
+// Message returns the message of the exception
+func (e *Exception) Message() string {
+	message, err := e.Object.GetMember("message")
+	if err != nil || message.Type() == types.TypeNull {
+		return ""
+	}
+	return message.String()
+}
+
+func (e *Exception) Error() string {
+	if e.Location != nil {
+		return fmt.Sprintf("Uncaught %s at %s: %s", e.Object.TypeName(), e.Location, e.Message())
+	}
+	return fmt.Sprintf("Uncaught %s: %s", e.Object.TypeName(), e.Message())
+}
+
+// StackTrace returns the message of the exception followed by the function calls it was thrown from, innermost first, see FormatStack
+func (e *Exception) StackTrace() string {
+	return fmt.Sprintf("Uncaught %s: %s\n", e.Object.TypeName(), e.Message()) + FormatStack(e.Stack, false)
+}
+
+// maxRecursionCycle is the most calls a cycle of recursive calls is made of to be collapsed by FormatStack
+const maxRecursionCycle = 8
+
+// FormatStack returns the function calls of a stack trace, innermost first, one per line.
+// Calls repeating the ones before them, which recursive functions leave on the stack, are collapsed into
+// how many more calls there are, e.g. "... 998 more calls to countdown", so exceeding the maximum call depth
+// doesn't print every call. If withSource is true, each call is followed by the line of code it was executing
+// with a marker pointing to the column
+func FormatStack(stack []*StackFrame, withSource bool) string {
+	var sb strings.Builder
+	for idx := 0; idx < len(stack); {
+		cycle, repeated := recursionCycle(stack, idx)
+		for _, frame := range stack[idx : idx+cycle] {
+			if frame.Location == nil {
+				sb.WriteString(fmt.Sprintf("  at %s\n", frame.Function))
+				continue
+			}
+			sb.WriteString(fmt.Sprintf("  at %s (%s)\n", frame.Function, frame.Location))
+			if withSource && frame.Location.Source != nil {
+				for _, line := range strings.Split(frame.Location.GetLineWithMarker(), "\n") {
+					sb.WriteString("    " + line + "\n")
+				}
+			}
+		}
+
+		if repeated > 0 {
+			functions := make([]string, cycle)
+			for offset, frame := range stack[idx : idx+cycle] {
+				functions[offset] = frame.Function
+			}
+			sb.WriteString(fmt.Sprintf("  ... %d more calls to %s\n", repeated*cycle, strings.Join(functions, ", ")))
+		}
+		idx += cycle * (repeated + 1)
+	}
+	return sb.String()
+}
+
+// recursionCycle returns the length of the shortest cycle of calls starting at index start that is repeated right after it,
+// and how many times it is repeated, or 1 and 0 if the call at start isn't part of such a cycle
+func recursionCycle(stack []*StackFrame, start int) (int, int) {
+	for cycle := 1; cycle <= maxRecursionCycle && start+2*cycle <= len(stack); cycle++ {
+		repeated := 0
+		for next := start + cycle; next+cycle <= len(stack) && sameCalls(stack[start:start+cycle], stack[next:next+cycle]); next += cycle {
+			repeated++
+		}
+		if repeated > 0 {
+			return cycle, repeated
+		}
+	}
+	return 1, 0
+}
+
+// sameCalls returns true if two runs of stack frames call the same functions from the same locations
+func sameCalls(a []*StackFrame, b []*StackFrame) bool {
+	for idx := range a {
+		if a[idx].Function != b[idx].Function || a[idx].Location == nil || b[idx].Location == nil ||
+			a[idx].Location.String() != b[idx].Location.String() {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,270 @@
+package types
+
+import (
+	"strings"
+	"zen/runtime"
+)
+
+// Unbounded is the capacity of dynamically sized arrays, declared with INF or without a size parameter
+const Unbounded = -1
+
+// Array is an ordered collection of elements of a single element type, optionally limited to a fixed capacity.
+// Arrays are reference values: every variable holding an array shares its elements
+type Array struct {
+	elements []Value
+	// ElementType is the type every element must satisfy
+	ElementType *TypeHint
+	// Capacity is the maximum number of elements, or Unbounded
+	Capacity int
+}
+
+// NewArray creates a new Array holding the given elements.
+// The elements are expected to satisfy the element type
+func NewArray(elements []Value, elementType *TypeHint, capacity int) *Array {
+	if elements == nil {
+		elements = make([]Value, 0)
+	}
+	return &Array{
+		elements:    elements,
+		ElementType: elementType,
+		Capacity:    capacity,
+	}
+}
+
+func (a *Array) Type() Type     { return TypeArray }
+func (a *Array) IsTruthy() bool { return true }
+
+// String returns the array as it would be written in zen code, e.g. ["a", "b"]
+func (a *Array) String() string {
+	elements := make([]string, len(a.elements))
+	for i, element := range a.elements {
+		elements[i] = Inspect(element)
+	}
+	return "[" + strings.Join(elements, ", ") + "]"
+}
+
+// Clone returns a shallow copy of the array
+func (a *Array) Clone() Value {
+	elements := make([]Value, len(a.elements))
+	copy(elements, a.elements)
+	return NewArray(elements, a.ElementType, a.Capacity)
+}
+
+// Equals returns true if the other value is an array with equal elements in the same order
+func (a *Array) Equals(other Value) bool {
+	o, ok := other.(*Array)
+	if !ok || len(o.elements) != len(a.elements) {
+		return false
+	}
+	for i, element := range a.elements {
+		if !element.Equals(o.elements[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// TypeHint returns the type of the array, e.g. Array<int, 3>
+func (a *Array) TypeHint() *TypeHint {
+	return NewArrayTypeHint(a.ElementType, a.Capacity, false)
+}
+
+// Length returns the number of elements
+func (a *Array) Length() int {
+	return len(a.elements)
+}
+
+// Elements returns the elements of the array
+func (a *Array) Elements() []Value {
+	return a.elements
+}
+
+// Get returns the element at the given index
+func (a *Array) Get(index Value) (Value, error) {
+	idx, err := a.index(index)
+	if err != nil {
+		return nil, err
+	}
+	return a.elements[idx], nil
+}
+
+// Set replaces the element at the given index
+func (a *Array) Set(index Value, value Value) error {
+	idx, err := a.index(index)
+	if err != nil {
+		return err
+	}
+	value, err = a.checkElement(value)
+	if err != nil {
+		return err
+	}
+	a.elements[idx] = value
+	return nil
+}
+
+// Append adds an element to the end of the array
+func (a *Array) Append(value Value) error {
+	if a.Capacity != Unbounded && len(a.elements) >= a.Capacity {
+		return NewTypeError("cannot append to array, exceeds array capacity of %d", a.Capacity)
+	}
+	value, err := a.checkElement(value)
+	if err != nil {
+		return err
+	}
+	a.elements = append(a.elements, value)
+	return nil
+}
+
+// First returns the first element, or null if the array is empty
+func (a *Array) First() Value {
+	if len(a.elements) == 0 {
+		return NewNull()
+	}
+	return a.elements[0]
+}
+
+// Last returns the last element, or null if the array is empty
+func (a *Array) Last() Value {
+	if len(a.elements) == 0 {
+		return NewNull()
+	}
+	return a.elements[len(a.elements)-1]
+}
+
+// Reverse flips the order of the elements in place
+func (a *Array) Reverse() {
+	for i, j := 0, len(a.elements)-1; i < j; i, j = i+1, j-1 {
+		a.elements[i], a.elements[j] = a.elements[j], a.elements[i]
+	}
+}
+
+// Slice returns a new dynamically sized array holding the elements from start up to and including end
+func (a *Array) Slice(start int, end int) (*Array, error) {
+	if start < 0 || end >= len(a.elements) || start > end {
+		return nil, NewTypeError("invalid slice range %d to %d for array of length %d", start, end, len(a.elements))
+	}
+	elements := make([]Value, end-start+1)
+	copy(elements, a.elements[start:end+1])
+	return NewArray(elements, a.ElementType, Unbounded), nil
+}
+
+// Resize changes the number of elements.
+// New elements are set to the zero value of the element type, while shrinking drops elements from the end
+func (a *Array) Resize(size int) error {
+	if size < 0 {
+		return NewTypeError("cannot resize array to negative size %d", size)
+	}
+	if a.Capacity != Unbounded && size > a.Capacity {
+		return NewTypeError("cannot resize array to %d, exceeds array capacity of %d", size, a.Capacity)
+	}
+
+	if size <= len(a.elements) {
+		a.elements = a.elements[:size]
+		return nil
+	}
+
+	elements := make([]Value, size)
+	copy(elements, a.elements)
+	for i := len(a.elements); i < size; i++ {
+		elements[i] = a.ElementType.ZeroValue()
+	}
+	a.elements = elements
+	return nil
+}
+
+// Constrain gives an untyped array (such as one created by an array literal) an element type and capacity.
+// Elements are checked and converted to the element type
+func (a *Array) Constrain(elementType *TypeHint, capacity int) error {
+	if capacity != Unbounded && len(a.elements) > capacity {
+		return NewTypeError("array of length %d exceeds array capacity of %d", len(a.elements), capacity)
+	}
+
+	elements := make([]Value, len(a.elements))
+	for i, element := range a.elements {
+		converted, err := elementType.Check(element)
+		if err != nil {
+			return NewTypeError("invalid element at index %d: %s", i, err.(*TypeError).Message)
+		}
+		elements[i] = converted
+	}
+
+	a.elements = elements
+	a.ElementType = elementType
+	a.Capacity = capacity
+	return nil
+}
+
+// IsUntyped returns true if the array accepts any elements without a size limit
+func (a *Array) IsUntyped() bool {
+	return a.ElementType.Name == "any" && a.Capacity == Unbounded
+}
+
+// GetMember returns the properties (first, last, length) and methods (append, reverse, slice, resize) of the array
+func (a *Array) GetMember(name string) (Value, error) {
+	switch name {
+	case "first":
+		return a.First(), nil
+	case "last":
+		return a.Last(), nil
+	case "length":
+		return NewInt(int32(len(a.elements))), nil
+	case "append":
+		return a.method(name, []*FunctionParameterHint{
+			NewFunctionParameterHint("value", TypeAny, true),
+		}, func(args map[string]Value) (Value, error) {
+			return nil, a.Append(args["value"])
+		}), nil
+	case "reverse":
+		return a.method(name, nil, func(args map[string]Value) (Value, error) {
+			a.Reverse()
+			return a, nil
+		}), nil
+	case "slice":
+		return a.method(name, []*FunctionParameterHint{
+			NewFunctionParameterHint("start", TypeInt, false),
+			NewFunctionParameterHint("end", TypeInt, false),
+		}, func(args map[string]Value) (Value, error) {
+			return a.Slice(int(args["start"].(*Int).Value()), int(args["end"].(*Int).Value()))
+		}), nil
+	case "resize":
+		return a.method(name, []*FunctionParameterHint{
+			NewFunctionParameterHint("size", TypeInt, false),
+		}, func(args map[string]Value) (Value, error) {
+			return nil, a.Resize(int(args["size"].(*Int).Value()))
+		}), nil
+	}
+	return nil, NewTypeError("Array has no member '%s'", name)
+}
+
+// method creates a built-in function bound to the array
+func (a *Array) method(name string, params []*FunctionParameterHint, fn func(args map[string]Value) (Value, error)) *BuiltinFunction {
+	return NewBuiltinFunction(name, params, nil, false, func(_ *runtime.EnvironmentInterface, args map[string]Value) (Value, error) {
+		return fn(args)
+	})
+}
+
+// index validates an index value and returns it as a Go int
+func (a *Array) index(index Value) (int, error) {
+	var idx int64
+	switch i := index.(type) {
+	case *Int:
+		idx = int64(i.Value())
+	case *Int64:
+		idx = i.Value()
+	default:
+		return 0, NewTypeError("array index must be an integer, got %s", index.Type())
+	}
+
+	if idx < 0 || idx >= int64(len(a.elements)) {
+		return 0, NewIndexError("array index %d out of bounds for length %d", idx, len(a.elements))
+	}
+	return int(idx), nil
+}
+
+// checkElement verifies that a value satisfies the element type, returning the (possibly converted) value
+func (a *Array) checkElement(value Value) (Value, error) {
+	if a.ElementType.Name == "any" {
+		return value, nil
+	}
+	return a.ElementType.Check(value)
+}
@@ -0,0 +1,33 @@
+package types
+
+// BoundMethod is a method accessed through an object, e.g. person.greet
+// Calling it executes the method with 'this' set to the receiver.
+// Overloaded methods such as init hold every overload, the one accepting the arguments is chosen when called
+type BoundMethod struct {
+	Receiver  *Object
+	Name      string
+	Overloads []*UserFunction
+}
+
+// NewBoundMethod creates a new BoundMethod
+func NewBoundMethod(receiver *Object, name string, overloads []*UserFunction) *BoundMethod {
+	return &BoundMethod{
+		Receiver:  receiver,
+		Name:      name,
+		Overloads: overloads,
+	}
+}
+
+func (m *BoundMethod) Type() Type     { return TypeFunction }
+func (m *BoundMethod) String() string { return "<method " + m.Receiver.Class.Name + "." + m.Name + ">" }
+func (m *BoundMethod) IsTruthy() bool { return true }
+func (m *BoundMethod) Clone() Value   { return m }
+func (m *BoundMethod) Equals(other Value) bool {
+	o, ok := other.(*BoundMethod)
+	return ok && o.Receiver == m.Receiver && o.Name == m.Name
+}
+
+// IsCallable implement Callable
+func (m *BoundMethod) IsCallable() bool {
+	return true
+}
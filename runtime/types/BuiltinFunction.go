@@ -11,12 +11,13 @@ type BuiltinFunction struct {
 	Func       func(env *runtime.EnvironmentInterface, args map[string]Value) (Value, error)
 }
 
-func (f *BuiltinFunction) String() string {
-
-}
-
-func (f *BuiltinFunction) Type() Type {
-	return TypeFunction
+func (f *BuiltinFunction) Type() Type     { return TypeFunction }
+func (f *BuiltinFunction) String() string { return "<builtin func " + f.Name + ">" }
+func (f *BuiltinFunction) IsTruthy() bool { return true }
+func (f *BuiltinFunction) Clone() Value   { return f }
+func (f *BuiltinFunction) Equals(other Value) bool {
+	o, ok := other.(*BuiltinFunction)
+	return ok && o == f
 }
 
 // IsCallable implement Callable
@@ -31,8 +32,8 @@ func (f *BuiltinFunction) Call(env *runtime.EnvironmentInterface, params map[str
 }
 
 // NewBuiltinFunction creates a new BuiltinFunction
-func NewBuiltinFunction(name string, parameters []*FunctionParameterHint, returnType interface{}, async bool, funcFunc func(env *runtime.EnvironmentInterface, params map[string]Value) (Value, error)) BuiltinFunction {
-	return BuiltinFunction{
+func NewBuiltinFunction(name string, parameters []*FunctionParameterHint, returnType interface{}, async bool, funcFunc func(env *runtime.EnvironmentInterface, params map[string]Value) (Value, error)) *BuiltinFunction {
+	return &BuiltinFunction{
 		Name:       name,
 		Parameters: parameters,
 		ReturnType: returnType,
@@ -4,3 +4,9 @@ package types
 type Callable interface {
 	IsCallable() bool
 }
+
+// IsCallable returns true if the value can be called like a function
+func IsCallable(v Value) bool {
+	c, ok := v.(Callable)
+	return ok && c.IsCallable()
+}
@@ -0,0 +1,181 @@
+package types
+
+import (
+	"zen/lang/common"
+	"zen/lang/parsing/ast"
+	"zen/runtime/environment"
+)
+
+// Class is a class declared in zen code. Calling a class creates a new Object
+type Class struct {
+	Name string
+	// TypeParameters of a generic class, e.g. T in class Box<T>. Objects hold the arguments given for them, see Object.TypeArguments
+	TypeParameters []*TypeParameter
+	// Superclass is the class this class extends, or nil
+	Superclass *Class
+	// Extends is the type the class extends, e.g. Box<T>, which is resolved again with the type arguments of each object
+	Extends ast.Expression
+	// Native is the Array or Map type a class extending a built-in type wraps, e.g. Array<int>, or nil.
+	// It is inherited by subclasses
+	Native *TypeHint
+	// Interfaces the class declares to implement, which its subclasses implement as well
+	Interfaces []*Interface
+	// Fields declared by this class in declaration order, see AllFields for inherited fields
+	Fields []*ClassField
+	// Methods declared by this class by name, bound to an object when accessed through it. See FindMethod
+	Methods map[string]*UserFunction
+	// Constructors holds the init overloads. If there are none, the inferred constructor takes the fields in order
+	Constructors []*UserFunction
+	// Traits the class is composed of, whose init is called on each new object before the constructor
+	Traits []*TraitUse
+	// Closure is the scope the class was declared in
+	Closure *environment.Scope
+}
+
+// ClassField is a field declared by a class, e.g. age: int = 0
+type ClassField struct {
+	Name string
+	Type *TypeHint
+	// TypeExpression is the declared type of a field of a generic class, resolved with the type arguments of each object
+	TypeExpression ast.Expression
+	// Default is the expression initializing the field, or nil for the zero value of its type
+	Default  ast.Expression
+	Constant bool
+	// Owner is the class declaring the field, or using the trait declaring it
+	Owner *Class
+	// Visibility restricts where the field can be read and assigned from, see CanAccess
+	Visibility ast.Visibility
+	// Closure is the scope the class or trait declaring the field was declared in, in which the default is evaluated
+	Closure  *environment.Scope
+	Location *common.SourceLocation
+}
+
+// NewClass creates a new Class without any fields or methods
+func NewClass(name string, closure *environment.Scope) *Class {
+	return &Class{
+		Name:         name,
+		Fields:       make([]*ClassField, 0),
+		Methods:      make(map[string]*UserFunction),
+		Constructors: make([]*UserFunction, 0),
+		Closure:      closure,
+	}
+}
+
+func (c *Class) Type() Type     { return TypeClass }
+func (c *Class) String() string { return "<class " + c.Name + ">" }
+func (c *Class) IsTruthy() bool { return true }
+func (c *Class) Clone() Value   { return c }
+func (c *Class) Equals(other Value) bool {
+	o, ok := other.(*Class)
+	return ok && o == c
+}
+
+// IsCallable implement Callable, calling a class creates an instance
+func (c *Class) IsCallable() bool {
+	return true
+}
+
+// GetField returns the field with the given name, declared by the class or inherited, or nil if there is no such field
+func (c *Class) GetField(name string) *ClassField {
+	for class := c; class != nil; class = class.Superclass {
+		for _, field := range class.Fields {
+			if field.Name == name {
+				return field
+			}
+		}
+	}
+	return nil
+}
+
+// AllFields returns the inherited fields followed by the fields declared by the class,
+// which is the parameter order of the inferred constructor
+func (c *Class) AllFields() []*ClassField {
+	if c.Superclass == nil {
+		return c.Fields
+	}
+	return append(append([]*ClassField{}, c.Superclass.AllFields()...), c.Fields...)
+}
+
+// FindMethod returns the method with the given name, looking through the superclasses if the class doesn't declare it.
+// Returns nil if there is no such method
+func (c *Class) FindMethod(name string) *UserFunction {
+	for class := c; class != nil; class = class.Superclass {
+		if method, ok := class.Methods[name]; ok {
+			return method
+		}
+	}
+	return nil
+}
+
+// InitOverloads returns the init constructors of the class.
+// A class without constructors or fields of its own inherits the constructors of its superclass
+func (c *Class) InitOverloads() []*UserFunction {
+	if len(c.Constructors) == 0 && len(c.Fields) == 0 && c.Superclass != nil {
+		return c.Superclass.InitOverloads()
+	}
+	return c.Constructors
+}
+
+// HasMember returns true if the class declares or inherits a field or method with the given name
+func (c *Class) HasMember(name string) bool {
+	return c.FindMethod(name) != nil || c.GetField(name) != nil
+}
+
+// Implements returns true if the class or one of its superclasses declares to implement an interface, see Interface.Satisfies
+func (c *Class) Implements(iface *Interface) bool {
+	for class := c; class != nil; class = class.Superclass {
+		for _, implemented := range class.Interfaces {
+			if implemented.Satisfies(iface) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// MemberVisibility returns the visibility of a field or method of the class, and the class declaring it.
+// Members that aren't declared by the class or its superclasses, such as native members, are public
+func (c *Class) MemberVisibility(name string) (ast.Visibility, *Class) {
+	if field := c.GetField(name); field != nil {
+		return field.Visibility, field.Owner
+	}
+	if method := c.FindMethod(name); method != nil {
+		return method.Visibility, method.Owner
+	}
+	return ast.Public, nil
+}
+
+// CanAccess returns true if code executing within the given class, or nil outside of any class,
+// can access a member of the given visibility declared by owner.
+// Private members are only accessible within the declaring class, protected members within the classes it extends
+// or is extended by, so a protected method overridden by a subclass can still be called by its superclass
+func CanAccess(from *Class, visibility ast.Visibility, owner *Class) bool {
+	switch visibility {
+	case ast.Private:
+		return from != nil && from == owner
+	case ast.Protected:
+		return from != nil && (from.IsSubclassOf(owner) || owner.IsSubclassOf(from))
+	default:
+		return true
+	}
+}
+
+// IsGeneric returns true if the class or a class it extends has type parameters
+func (c *Class) IsGeneric() bool {
+	for class := c; class != nil; class = class.Superclass {
+		if len(class.TypeParameters) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSubclassOf returns true if the class is other or extends it, directly or indirectly
+func (c *Class) IsSubclassOf(other *Class) bool {
+	for class := c; class != nil; class = class.Superclass {
+		if class == other {
+			return true
+		}
+	}
+	return false
+}
@@ -1,7 +1,10 @@
 package types
 
 import (
+	"strings"
 	"zen/lang/parsing/ast"
+	"zen/lang/parsing/expression"
+	"zen/runtime/environment"
 )
 
 // FunctionParameterHint represents a parameter for a function
@@ -20,26 +23,103 @@ func NewFunctionParameterHint(name string, typ Type, nullable bool) *FunctionPar
 	}
 }
 
-// UserFunction represents a function which have a set of parameters and a return type
+// UserFunction represents a function or lambda declared in zen code, which have a set of parameters and a return type
 type UserFunction struct {
-	Name       string
-	Parameters []FunctionParameterHint
-	ReturnType interface{}
-	Body       []*ast.Statement
+	Name string
+	// TypeParameters of a generic function, e.g. T in func first<T>(xs: Array<T>): T
+	TypeParameters []*TypeParameter
+	Parameters     []expression.FuncParameterExpression
+	// ReturnType is nil for lambdas, whose return type is inferred
+	ReturnType ast.Expression
+	Body       []ast.Statement
 	Async      bool
-}
-
-// IsCallable implement Callable
-func (f UserFunction) IsCallable() bool {
-	return true
+	// Closure is the scope the function was declared in.
+	// Each call executes in a new scope whose parent is the closure, so captured variables outlive the declaring call
+	Closure *environment.Scope
+	// Lambda is true for anonymous functions, which yield the value of their final expression if they don't return
+	Lambda bool
+	// Owner is the class declaring the function if it is a method, which 'super' refers to the superclass of
+	Owner *Class
+	// Enclosing is the class of the method a nested function or lambda was declared in, see AccessClass
+	Enclosing *Class
+	// Visibility restricts where a method can be called from, see CanAccess
+	Visibility ast.Visibility
+	// Aliases maps the members of a trait renamed by the class using it to their name in the class.
+	// Within methods of the trait, and functions declared in them, this.member refers to the renamed member
+	Aliases map[string]string
 }
 
 // NewUserFunction creates a new UserFunction
-func NewUserFunction(name string, parameters []FunctionParameterHint, returnType interface{}, async bool) UserFunction {
-	return UserFunction{
+func NewUserFunction(name string, parameters []expression.FuncParameterExpression, returnType ast.Expression, body []ast.Statement, async bool, closure *environment.Scope) *UserFunction {
+	return &UserFunction{
 		Name:       name,
 		Parameters: parameters,
 		ReturnType: returnType,
+		Body:       body,
 		Async:      async,
+		Closure:    closure,
+	}
+}
+
+// AccessClass returns the class whose private and protected members the function can access:
+// the class declaring it for methods, or the class of the enclosing method for nested functions and lambdas
+func (f *UserFunction) AccessClass() *Class {
+	if f.Owner != nil {
+		return f.Owner
+	}
+	return f.Enclosing
+}
+
+// NewLambda creates a new anonymous UserFunction capturing the given scope
+func NewLambda(parameters []expression.FuncParameterExpression, body []ast.Statement, closure *environment.Scope) *UserFunction {
+	return &UserFunction{
+		Name:       "lambda",
+		Parameters: parameters,
+		Body:       body,
+		Closure:    closure,
+		Lambda:     true,
+	}
+}
+
+func (f *UserFunction) Type() Type {
+	if f.Lambda {
+		return TypeLambda
 	}
+	return TypeFunction
+}
+
+func (f *UserFunction) IsTruthy() bool { return true }
+func (f *UserFunction) Clone() Value   { return f }
+func (f *UserFunction) Equals(other Value) bool {
+	o, ok := other.(*UserFunction)
+	return ok && o == f
+}
+
+// String returns the signature of the function, e.g. func add(a:int, b:int): int
+func (f *UserFunction) String() string {
+	params := make([]string, len(f.Parameters))
+	for i, param := range f.Parameters {
+		params[i] = param.Name
+		if param.Type != nil {
+			params[i] += ":" + param.Type.String(0)
+		}
+		if param.IsNullable {
+			params[i] += "?"
+		}
+	}
+
+	if f.Lambda {
+		return "{ " + strings.Join(params, ", ") + " -> ... }"
+	}
+
+	str := "func " + f.Name + "(" + strings.Join(params, ", ") + ")"
+	if f.ReturnType != nil {
+		str += ": " + f.ReturnType.String(0)
+	}
+	return str
+}
+
+// IsCallable implement Callable
+func (f *UserFunction) IsCallable() bool {
+	return true
 }
@@ -0,0 +1,105 @@
+package types
+
+import (
+	"zen/lang/parsing/ast"
+	"zen/lang/parsing/expression"
+	"zen/runtime/environment"
+)
+
+// Interface is an interface declared in zen code: the properties and method signatures
+// a class must have to implement it
+type Interface struct {
+	Name string
+	// TypeParameters of a generic interface, e.g. K, V in interface BracketGet<K, V>
+	TypeParameters []*TypeParameter
+	// Closure is the scope the members of a generic interface are resolved in, defining its type parameters
+	Closure *environment.Scope
+	// Generic is the generic interface this one was instantiated from with TypeArguments, e.g. BracketGet for BracketGet<int, int>
+	Generic       *Interface
+	TypeArguments []Value
+	// Properties in declaration order
+	Properties []*InterfaceProperty
+	// Methods in declaration order
+	Methods []*InterfaceMethod
+}
+
+// InterfaceProperty is a property required by an interface, e.g. var position: Vector2
+type InterfaceProperty struct {
+	Name string
+	Type *TypeHint
+	// TypeExpression is the declared type, resolved again when a generic interface is instantiated
+	TypeExpression ast.Expression
+	Constant       bool
+	// Visibility is public or protected, implementing fields may not be less visible
+	Visibility ast.Visibility
+}
+
+// InterfaceMethod is a method required by an interface, e.g. draw(): void
+type InterfaceMethod struct {
+	Name string
+	// Signature is the function type of the method, e.g. func(int): string
+	Signature *TypeHint
+	// Parameters and ReturnType are the declared signature, resolved again when a generic interface is instantiated
+	Parameters []expression.FuncParameterExpression
+	ReturnType ast.Expression
+	// Visibility is public or protected, implementing methods may not be less visible
+	Visibility ast.Visibility
+}
+
+// NewInterface creates a new Interface without any members
+func NewInterface(name string) *Interface {
+	return &Interface{
+		Name:       name,
+		Properties: make([]*InterfaceProperty, 0),
+		Methods:    make([]*InterfaceMethod, 0),
+	}
+}
+
+func (i *Interface) Type() Type     { return TypeInterface }
+func (i *Interface) String() string { return "<interface " + i.FullName() + ">" }
+func (i *Interface) IsTruthy() bool { return true }
+func (i *Interface) Clone() Value   { return i }
+func (i *Interface) Equals(other Value) bool {
+	o, ok := other.(*Interface)
+	return ok && o == i
+}
+
+// HasMember returns true if the interface declares a property or method with the given name
+func (i *Interface) HasMember(name string) bool {
+	for _, property := range i.Properties {
+		if property.Name == name {
+			return true
+		}
+	}
+	for _, method := range i.Methods {
+		if method.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// FullName returns the name of the interface with its type arguments, if it was instantiated from a generic interface,
+// e.g. BracketGet<int, int>
+func (i *Interface) FullName() string {
+	if len(i.TypeArguments) == 0 {
+		return i.Name
+	}
+	return i.Name + "<" + FormatTypeArguments(i.TypeArguments) + ">"
+}
+
+// Satisfies returns true if a class declaring to implement this interface implements the given one:
+// either the same interface, or the same generic interface, instantiated with the same type arguments unless none are given,
+// e.g. a class implementing BracketGet<int, int> implements BracketGet but not BracketGet<string, int>
+func (i *Interface) Satisfies(iface *Interface) bool {
+	if i == iface {
+		return true
+	}
+	if i.Generic == nil {
+		return false
+	}
+	if iface == i.Generic {
+		return true
+	}
+	return iface.Generic == i.Generic && FormatTypeArguments(iface.TypeArguments) == FormatTypeArguments(i.TypeArguments)
+}
@@ -0,0 +1,78 @@
+package types
+
+// Iterable is implemented by values that can be looped over with for-in
+type Iterable interface {
+	Value
+	// Iterator returns a new Iterator positioned before the first element
+	Iterator() Iterator
+}
+
+// Iterator steps through the elements of an Iterable
+type Iterator interface {
+	// Next returns the key and value of the next element, ok is false once there are no more elements.
+	// Keys are indices for sequences such as arrays
+	Next() (key Value, value Value, ok bool)
+}
+
+// sliceIterator iterates over a fixed list of keys and values
+type sliceIterator struct {
+	keys   []Value
+	values []Value
+	pos    int
+}
+
+func (it *sliceIterator) Next() (Value, Value, bool) {
+	if it.pos >= len(it.values) {
+		return nil, nil, false
+	}
+	key, value := it.keys[it.pos], it.values[it.pos]
+	it.pos++
+	return key, value, true
+}
+
+// Iterator returns an iterator over the index and value of each element.
+// Elements appended during iteration are not visited
+func (a *Array) Iterator() Iterator {
+	keys := make([]Value, len(a.elements))
+	values := make([]Value, len(a.elements))
+	for i, element := range a.elements {
+		keys[i] = NewInt(int32(i))
+		values[i] = element
+	}
+	return &sliceIterator{keys: keys, values: values}
+}
+
+// Iterator returns an iterator over the key and value of each entry, in insertion order.
+// Entries added during iteration are not visited
+func (m *Map) Iterator() Iterator {
+	keys := make([]Value, len(m.entries))
+	values := make([]Value, len(m.entries))
+	for i, entry := range m.entries {
+		keys[i] = entry.Key
+		values[i] = entry.Value
+	}
+	return &sliceIterator{keys: keys, values: values}
+}
+
+// FallibleIterator is implemented by iterators that can fail while stepping through the elements, such as those of a View.
+// Next returns no more elements once it failed
+type FallibleIterator interface {
+	Iterator
+	// Err returns the error the iteration failed with, if any
+	Err() error
+}
+
+// SizedIterator is implemented by iterators that know their total number of elements up front
+type SizedIterator interface {
+	Iterator
+	// Len returns the total number of elements, including those already visited
+	Len() int64
+}
+
+func (it *sliceIterator) Len() int64 {
+	return int64(len(it.values))
+}
+
+func (it *rangeIterator) Len() int64 {
+	return it.r.Length()
+}
@@ -0,0 +1,53 @@
+package types
+
+import "fmt"
+
+// LoopInfo is the read-only 'loop' object available inside for-in loops,
+// describing the current iteration: loop.index, loop.first, loop.last and loop.length
+type LoopInfo struct {
+	Index int64
+	// Length is the total number of iterations, or -1 if it isn't known up front
+	Length int64
+}
+
+// NewLoopInfo creates the loop object for one iteration
+func NewLoopInfo(index int64, length int64) *LoopInfo {
+	return &LoopInfo{Index: index, Length: length}
+}
+
+func (l *LoopInfo) Type() Type     { return TypeObject }
+func (l *LoopInfo) IsTruthy() bool { return true }
+func (l *LoopInfo) Clone() Value   { return NewLoopInfo(l.Index, l.Length) }
+func (l *LoopInfo) String() string {
+	if l.Length < 0 {
+		return fmt.Sprintf("<loop index=%d first=%t>", l.Index, l.Index == 0)
+	}
+	return fmt.Sprintf("<loop index=%d first=%t last=%t>", l.Index, l.Index == 0, l.Index == l.Length-1)
+}
+func (l *LoopInfo) Equals(other Value) bool {
+	o, ok := other.(*LoopInfo)
+	return ok && o == l
+}
+
+// GetMember returns the properties of the loop object.
+// length and last are null when the number of iterations isn't known up front, e.g. under a where-clause,
+// since finding out would mean evaluating the condition for the next element before the body has run
+func (l *LoopInfo) GetMember(name string) (Value, error) {
+	switch name {
+	case "index":
+		return NewInt64(l.Index), nil
+	case "first":
+		return NewBool(l.Index == 0), nil
+	case "last":
+		if l.Length < 0 {
+			return NewNull(), nil
+		}
+		return NewBool(l.Index == l.Length-1), nil
+	case "length":
+		if l.Length < 0 {
+			return NewNull(), nil
+		}
+		return NewInt64(l.Length), nil
+	}
+	return nil, NewTypeError("loop has no member '%s'", name)
+}
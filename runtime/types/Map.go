@@ -0,0 +1,248 @@
+package types
+
+import (
+	"strings"
+	"zen/runtime"
+)
+
+// Map associates keys with values. Entries keep their insertion order, so iterating a map is deterministic.
+// Maps are reference values: every variable holding a map shares its entries
+type Map struct {
+	entries []*MapEntry
+	// index maps the hash key of each entry's key to its position in entries
+	index map[mapKey]int
+	// KeyType is the type every key must satisfy
+	KeyType *TypeHint
+	// ValueType is the type every value must satisfy
+	ValueType *TypeHint
+}
+
+// MapEntry is a single key/value pair of a Map
+type MapEntry struct {
+	Key   Value
+	Value Value
+}
+
+// mapKey is the comparable form of a key.
+// Numbers of the same kind compare by value regardless of their size, other values by identity
+type mapKey struct {
+	kind  Type
+	value interface{}
+}
+
+// NewMap creates a new empty Map
+func NewMap(keyType *TypeHint, valueType *TypeHint) *Map {
+	return &Map{
+		entries:   make([]*MapEntry, 0),
+		index:     make(map[mapKey]int),
+		KeyType:   keyType,
+		ValueType: valueType,
+	}
+}
+
+func (m *Map) Type() Type     { return TypeMap }
+func (m *Map) IsTruthy() bool { return true }
+
+// String returns the map as it would be written in zen code, e.g. {"volume": 0.5}
+func (m *Map) String() string {
+	entries := make([]string, len(m.entries))
+	for i, entry := range m.entries {
+		entries[i] = Inspect(entry.Key) + ": " + Inspect(entry.Value)
+	}
+	return "{" + strings.Join(entries, ", ") + "}"
+}
+
+// Clone returns a shallow copy of the map
+func (m *Map) Clone() Value {
+	clone := NewMap(m.KeyType, m.ValueType)
+	for _, entry := range m.entries {
+		clone.put(entry.Key, entry.Value)
+	}
+	return clone
+}
+
+// Equals returns true if the other value is a map with equal entries
+func (m *Map) Equals(other Value) bool {
+	o, ok := other.(*Map)
+	if !ok || len(o.entries) != len(m.entries) {
+		return false
+	}
+	for _, entry := range m.entries {
+		value, ok := o.lookup(entry.Key)
+		if !ok || !value.Equals(entry.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+// TypeHint returns the type of the map, e.g. Map<string, int>
+func (m *Map) TypeHint() *TypeHint {
+	return NewMapTypeHint(m.KeyType, m.ValueType, false)
+}
+
+// Size returns the number of entries
+func (m *Map) Size() int {
+	return len(m.entries)
+}
+
+// Entries returns the entries of the map in insertion order
+func (m *Map) Entries() []*MapEntry {
+	return m.entries
+}
+
+// Get returns the value associated with a key, or an error if the key doesn't exist
+func (m *Map) Get(key Value) (Value, error) {
+	value, ok := m.lookup(key)
+	if !ok {
+		return nil, NewTypeError("map has no key %s", Inspect(key))
+	}
+	return value, nil
+}
+
+// Set associates a value with a key. New keys are added after the existing entries
+func (m *Map) Set(key Value, value Value) error {
+	key, err := m.check(m.KeyType, key)
+	if err != nil {
+		return NewTypeError("invalid map key: %s", err.(*TypeError).Message)
+	}
+	value, err = m.check(m.ValueType, value)
+	if err != nil {
+		return NewTypeError("invalid map value for key %s: %s", Inspect(key), err.(*TypeError).Message)
+	}
+	m.put(key, value)
+	return nil
+}
+
+// Has returns true if the key exists
+func (m *Map) Has(key Value) bool {
+	_, ok := m.lookup(key)
+	return ok
+}
+
+// Contains returns true if any key is associated with a value equal to the given value
+func (m *Map) Contains(value Value) bool {
+	for _, entry := range m.entries {
+		if entry.Value.Equals(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Constrain gives an untyped map (such as one created by a map literal) a key and value type.
+// Keys and values are checked and converted to their types
+func (m *Map) Constrain(keyType *TypeHint, valueType *TypeHint) error {
+	constrained := NewMap(keyType, valueType)
+	for _, entry := range m.entries {
+		if err := constrained.Set(entry.Key, entry.Value); err != nil {
+			return err
+		}
+	}
+
+	m.entries = constrained.entries
+	m.index = constrained.index
+	m.KeyType = keyType
+	m.ValueType = valueType
+	return nil
+}
+
+// IsUntyped returns true if the map accepts keys and values of any type
+func (m *Map) IsUntyped() bool {
+	return m.KeyType.Name == "any" && m.ValueType.Name == "any"
+}
+
+// GetMember returns the methods of the map (getSize, has, get, contains).
+// Any other name reads the entry with that name as its key, so config.volume is the same as config{"volume"}
+func (m *Map) GetMember(name string) (Value, error) {
+	switch name {
+	case "getSize":
+		return m.method(name, nil, func(args map[string]Value) (Value, error) {
+			return NewInt(int32(len(m.entries))), nil
+		}), nil
+	case "has":
+		return m.method(name, []*FunctionParameterHint{
+			NewFunctionParameterHint("key", TypeAny, false),
+		}, func(args map[string]Value) (Value, error) {
+			return NewBool(m.Has(args["key"])), nil
+		}), nil
+	case "get":
+		return m.method(name, []*FunctionParameterHint{
+			NewFunctionParameterHint("key", TypeAny, false),
+		}, func(args map[string]Value) (Value, error) {
+			if value, ok := m.lookup(args["key"]); ok {
+				return value, nil
+			}
+			return NewNull(), nil
+		}), nil
+	case "contains":
+		return m.method(name, []*FunctionParameterHint{
+			NewFunctionParameterHint("value", TypeAny, true),
+		}, func(args map[string]Value) (Value, error) {
+			return NewBool(m.Contains(args["value"])), nil
+		}), nil
+	}
+
+	if value, ok := m.lookup(NewString(name)); ok {
+		return value, nil
+	}
+	return nil, NewTypeError("Map has no member or key '%s'", name)
+}
+
+// method creates a built-in function bound to the map
+func (m *Map) method(name string, params []*FunctionParameterHint, fn func(args map[string]Value) (Value, error)) *BuiltinFunction {
+	return NewBuiltinFunction(name, params, nil, false, func(_ *runtime.EnvironmentInterface, args map[string]Value) (Value, error) {
+		return fn(args)
+	})
+}
+
+// lookup returns the value associated with a key
+func (m *Map) lookup(key Value) (Value, bool) {
+	idx, ok := m.index[hashKey(key)]
+	if !ok {
+		return nil, false
+	}
+	return m.entries[idx].Value, true
+}
+
+// put sets the value of an existing key or appends a new entry
+func (m *Map) put(key Value, value Value) {
+	hash := hashKey(key)
+	if idx, ok := m.index[hash]; ok {
+		m.entries[idx].Value = value
+		return
+	}
+	m.index[hash] = len(m.entries)
+	m.entries = append(m.entries, &MapEntry{Key: key, Value: value})
+}
+
+// check verifies that a key or value satisfies its type, returning the (possibly converted) value
+func (m *Map) check(hint *TypeHint, v Value) (Value, error) {
+	if hint.Name == "any" {
+		return v, nil
+	}
+	return hint.Check(v)
+}
+
+// hashKey returns the comparable form of a key
+func hashKey(key Value) mapKey {
+	switch k := key.(type) {
+	case *Int:
+		return mapKey{kind: TypeInt64, value: int64(k.Value())}
+	case *Int64:
+		return mapKey{kind: TypeInt64, value: k.Value()}
+	case *Float:
+		return mapKey{kind: TypeFloat64, value: float64(k.Value())}
+	case *Float64:
+		return mapKey{kind: TypeFloat64, value: k.Value()}
+	case *String:
+		return mapKey{kind: TypeString, value: k.Value()}
+	case *Bool:
+		return mapKey{kind: TypeBool, value: k.Value()}
+	case *Null:
+		return mapKey{kind: TypeNull}
+	default:
+		// Other values, such as objects and collections, are keyed by identity
+		return mapKey{kind: key.Type(), value: key}
+	}
+}
@@ -0,0 +1,15 @@
+package types
+
+// HasMembers is implemented by values exposing properties and methods through member access, e.g. names.length
+type HasMembers interface {
+	Value
+	// GetMember returns the property or bound method with the given name
+	GetMember(name string) (Value, error)
+}
+
+// HasMutableMembers is implemented by values whose properties can be assigned, e.g. this.name = "john"
+type HasMutableMembers interface {
+	HasMembers
+	// SetMember assigns a value to the property with the given name
+	SetMember(name string, value Value) error
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Namespace holds the symbols of a module or namespace imported under an alias, e.g. gec.hello() after import GameEngine/Core as gec.
+// Its members are read-only
+type Namespace struct {
+	// path of the imported module or namespace, e.g. GameEngine/Core
+	path    string
+	names   []string
+	members map[string]Value
+}
+
+// NewNamespace creates an empty namespace for the module or namespace with the given path
+func NewNamespace(path string) *Namespace {
+	return &Namespace{
+		path:    path,
+		members: make(map[string]Value),
+	}
+}
+
+// Define adds a symbol to the namespace, replacing a symbol of the same name
+func (n *Namespace) Define(name string, value Value) {
+	if _, exists := n.members[name]; !exists {
+		n.names = append(n.names, name)
+	}
+	n.members[name] = value
+}
+
+// Path returns the path of the imported module or namespace
+func (n *Namespace) Path() string {
+	return n.path
+}
+
+// Names returns the names of the symbols in the namespace, in the order they were defined
+func (n *Namespace) Names() []string {
+	return append([]string{}, n.names...)
+}
+
+func (n *Namespace) Type() Type     { return TypeNamespace }
+func (n *Namespace) IsTruthy() bool { return true }
+
+// Clone returns the namespace itself, since it can't be modified
+func (n *Namespace) Clone() Value { return n }
+
+func (n *Namespace) String() string {
+	return fmt.Sprintf("namespace %s { %s }", n.path, strings.Join(n.names, ", "))
+}
+
+func (n *Namespace) Equals(other Value) bool {
+	return n == other
+}
+
+// GetMember returns the symbol with the given name
+func (n *Namespace) GetMember(name string) (Value, error) {
+	if value, exists := n.members[name]; exists {
+		return value, nil
+	}
+	return nil, NewTypeError("Namespace '%s' has no member '%s'", n.path, name)
+}
@@ -0,0 +1,182 @@
+package types
+
+import (
+	"strings"
+)
+
+// The methods a class declares so its objects support array and curly access, e.g. grid[0] or config{"volume"}.
+// items[key] calls items._bracketGet(key), and items[key] = value calls items._bracketSet(key, value)
+const (
+	BracketGetMethod = "_bracketGet"
+	BracketSetMethod = "_bracketSet"
+)
+
+// Object is an instance of a Class.
+// Objects are reference values: every variable holding an object shares its fields
+type Object struct {
+	Class  *Class
+	fields map[string]Value
+	// native is the Array or Map an instance of a class extending a built-in type wraps, or nil
+	native Value
+	// typeArguments holds the type arguments of the object for its class and each generic class it extends
+	typeArguments map[*Class][]Value
+	// fieldTypes holds the types of the fields of generic classes, resolved with the object's type arguments
+	fieldTypes map[string]*TypeHint
+}
+
+// NewObject creates a new instance of a class with all fields set to null.
+// The interpreter initializes the fields before the object is used, see InitField.
+// Instances of classes extending Array or Map start out with an empty array or map
+func NewObject(class *Class) *Object {
+	allFields := class.AllFields()
+	fields := make(map[string]Value, len(allFields))
+	for _, field := range allFields {
+		fields[field.Name] = NewNull()
+	}
+	var native Value
+	if class.Native != nil {
+		native = class.Native.ZeroValue()
+	}
+	return &Object{
+		Class:  class,
+		fields: fields,
+		native: native,
+	}
+}
+
+// Native returns the Array or Map wrapped by an instance of a class extending a built-in type, or nil
+func (o *Object) Native() Value {
+	return o.native
+}
+
+// SetNative replaces the Array or Map wrapped by an object, used when the wrapped type depends on type arguments
+func (o *Object) SetNative(native Value) {
+	o.native = native
+}
+
+// TypeArguments returns the type arguments the object was created with for a generic class it is an instance of,
+// or nil if the class isn't generic
+func (o *Object) TypeArguments(class *Class) []Value {
+	return o.typeArguments[class]
+}
+
+// SetTypeArguments sets the type arguments of the object for a generic class it is an instance of
+func (o *Object) SetTypeArguments(class *Class, args []Value) {
+	if o.typeArguments == nil {
+		o.typeArguments = make(map[*Class][]Value)
+	}
+	o.typeArguments[class] = args
+}
+
+// FieldType returns the type of a field of the object, which for fields of generic classes depends on the type arguments
+func (o *Object) FieldType(field *ClassField) *TypeHint {
+	if hint, ok := o.fieldTypes[field.Name]; ok {
+		return hint
+	}
+	return field.Type
+}
+
+// SetFieldType sets the type of a field of a generic class, see FieldType
+func (o *Object) SetFieldType(field *ClassField, hint *TypeHint) {
+	if o.fieldTypes == nil {
+		o.fieldTypes = make(map[string]*TypeHint)
+	}
+	o.fieldTypes[field.Name] = hint
+}
+
+// TypeName returns the name of the object's class with its type arguments, e.g. Box<int>
+func (o *Object) TypeName() string {
+	if args := o.typeArguments[o.Class]; len(args) > 0 {
+		return o.Class.Name + "<" + FormatTypeArguments(args) + ">"
+	}
+	return o.Class.Name
+}
+
+// Method returns a method of the object's class bound to the object, or nil if the class has no method with that name
+func (o *Object) Method(name string) *BoundMethod {
+	if method := o.Class.FindMethod(name); method != nil {
+		return NewBoundMethod(o, name, []*UserFunction{method})
+	}
+	return nil
+}
+
+// Unwrap returns the Array or Map wrapped by an object extending a built-in type, or the value itself otherwise
+func Unwrap(v Value) Value {
+	if obj, ok := v.(*Object); ok && obj.native != nil {
+		return obj.native
+	}
+	return v
+}
+
+func (o *Object) Type() Type     { return TypeObject }
+func (o *Object) IsTruthy() bool { return true }
+func (o *Object) Clone() Value   { return o }
+func (o *Object) Equals(other Value) bool {
+	obj, ok := other.(*Object)
+	return ok && obj == o
+}
+
+// String returns the class name and fields of the object, e.g. Person{name: "john", age: 30}
+// Objects extending a built-in type show the wrapped value first, e.g. Stack[1, 2]{limit: 10}
+func (o *Object) String() string {
+	allFields := o.Class.AllFields()
+	fields := make([]string, len(allFields))
+	for i, field := range allFields {
+		fields[i] = field.Name + ": " + Inspect(o.fields[field.Name])
+	}
+	if o.native != nil {
+		if len(fields) == 0 {
+			return o.Class.Name + o.native.String()
+		}
+		return o.Class.Name + o.native.String() + "{" + strings.Join(fields, ", ") + "}"
+	}
+	return o.Class.Name + "{" + strings.Join(fields, ", ") + "}"
+}
+
+// GetMember returns the value of a field, or a method bound to the object.
+// Methods are looked up from the class of the object, so a method overridden by a subclass is always the one called.
+// Objects extending a built-in type fall back to the members of the wrapped value, e.g. length
+func (o *Object) GetMember(name string) (Value, error) {
+	if value, ok := o.fields[name]; ok {
+		return value, nil
+	}
+	if method := o.Class.FindMethod(name); method != nil {
+		return NewBoundMethod(o, name, []*UserFunction{method}), nil
+	}
+	if name == "init" {
+		if constructors := o.Class.InitOverloads(); len(constructors) > 0 {
+			return NewBoundMethod(o, name, constructors), nil
+		}
+	}
+	if container, ok := o.native.(HasMembers); ok {
+		if value, err := container.GetMember(name); err == nil {
+			return value, nil
+		}
+	}
+	return nil, NewTypeError("%s has no member '%s'", o.Class.Name, name)
+}
+
+// SetMember assigns a value to a field, checking it against the field's type
+func (o *Object) SetMember(name string, value Value) error {
+	field := o.Class.GetField(name)
+	if field == nil {
+		if o.Class.FindMethod(name) != nil {
+			return NewTypeError("cannot assign to method '%s' of %s", name, o.Class.Name)
+		}
+		return NewTypeError("%s has no field '%s'", o.Class.Name, name)
+	}
+	if field.Constant {
+		return NewTypeError("cannot assign to constant field '%s' of %s", name, o.Class.Name)
+	}
+	return o.InitField(field, value)
+}
+
+// InitField sets a field to a value checked against the field's type, ignoring whether the field is constant
+func (o *Object) InitField(field *ClassField, value Value) error {
+	value, err := o.FieldType(field).Check(value)
+	if err != nil {
+		return NewTypeError("invalid value for field '%s' of %s: %s", field.Name, o.Class.Name, err.(*TypeError).Message)
+	}
+	o.fields[field.Name] = value
+	return nil
+}
@@ -0,0 +1,127 @@
+package types
+
+import (
+	"strconv"
+	"zen/runtime"
+)
+
+// Range is a lazy sequence of integers from Start up to, but not including, End, e.g. 3..5.
+// Ranges where Start is greater than End count down. Elements are computed on demand, never stored
+type Range struct {
+	Start int64
+	End   int64
+	// Step is the difference between consecutive elements, negative for descending ranges
+	Step int64
+}
+
+// NewRange creates a range from start to end, counting up or down by 1
+func NewRange(start int64, end int64) *Range {
+	step := int64(1)
+	if start > end {
+		step = -1
+	}
+	return &Range{Start: start, End: end, Step: step}
+}
+
+func (r *Range) Type() Type     { return TypeRange }
+func (r *Range) IsTruthy() bool { return true }
+func (r *Range) Clone() Value   { return &Range{Start: r.Start, End: r.End, Step: r.Step} }
+
+// String returns the range as it would be written in zen code, e.g. 3..5 or (0..10).step(2)
+func (r *Range) String() string {
+	str := strconv.FormatInt(r.Start, 10) + ".." + strconv.FormatInt(r.End, 10)
+	if r.Step != 1 && r.Step != -1 {
+		return "(" + str + ").step(" + strconv.FormatInt(r.Step, 10) + ")"
+	}
+	return str
+}
+
+// Equals returns true if the other value is a range with the same bounds and step
+func (r *Range) Equals(other Value) bool {
+	o, ok := other.(*Range)
+	return ok && o.Start == r.Start && o.End == r.End && o.Step == r.Step
+}
+
+// WithStep returns a copy of the range with a different step.
+// The step must move from Start towards End
+func (r *Range) WithStep(step int64) (*Range, error) {
+	if step == 0 {
+		return nil, NewTypeError("range step cannot be 0")
+	}
+	if (r.Start < r.End && step < 0) || (r.Start > r.End && step > 0) {
+		return nil, NewTypeError("range step %d never reaches the end of %s", step, r)
+	}
+	return &Range{Start: r.Start, End: r.End, Step: step}, nil
+}
+
+// Length returns the number of elements in the range
+func (r *Range) Length() int64 {
+	if r.Step > 0 && r.Start < r.End {
+		return (r.End - r.Start + r.Step - 1) / r.Step
+	}
+	if r.Step < 0 && r.Start > r.End {
+		return (r.Start - r.End - r.Step - 1) / -r.Step
+	}
+	return 0
+}
+
+// Contains returns true if n is one of the elements of the range
+func (r *Range) Contains(n int64) bool {
+	if r.Step > 0 {
+		if n < r.Start || n >= r.End {
+			return false
+		}
+		return (n-r.Start)%r.Step == 0
+	}
+	if n > r.Start || n <= r.End {
+		return false
+	}
+	return (r.Start-n)%-r.Step == 0
+}
+
+// Iterator returns an iterator over the position and value of each element
+func (r *Range) Iterator() Iterator {
+	return &rangeIterator{r: r, next: r.Start}
+}
+
+// GetMember returns the properties (start, end, step, length) and methods (contains, step) of the range
+func (r *Range) GetMember(name string) (Value, error) {
+	switch name {
+	case "start":
+		return NewInt64(r.Start), nil
+	case "end":
+		return NewInt64(r.End), nil
+	case "length":
+		return NewInt64(r.Length()), nil
+	case "contains":
+		return NewBuiltinFunction(name, []*FunctionParameterHint{
+			NewFunctionParameterHint("value", TypeInt64, false),
+		}, nil, false, func(_ *runtime.EnvironmentInterface, args map[string]Value) (Value, error) {
+			return NewBool(r.Contains(args["value"].(*Int64).Value())), nil
+		}), nil
+	case "step":
+		return NewBuiltinFunction(name, []*FunctionParameterHint{
+			NewFunctionParameterHint("step", TypeInt64, false),
+		}, nil, false, func(_ *runtime.EnvironmentInterface, args map[string]Value) (Value, error) {
+			return r.WithStep(args["step"].(*Int64).Value())
+		}), nil
+	}
+	return nil, NewTypeError("Range has no member '%s'", name)
+}
+
+// rangeIterator computes the elements of a range one at a time
+type rangeIterator struct {
+	r     *Range
+	next  int64
+	index int32
+}
+
+func (it *rangeIterator) Next() (Value, Value, bool) {
+	if (it.r.Step > 0 && it.next >= it.r.End) || (it.r.Step < 0 && it.next <= it.r.End) {
+		return nil, nil, false
+	}
+	key, value := NewInt(it.index), NewInt64(it.next)
+	it.index++
+	it.next += it.r.Step
+	return key, value, true
+}
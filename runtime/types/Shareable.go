@@ -0,0 +1,30 @@
+package types
+
+// Shareable is implemented by values that are safe to share between isolates even though they are mutable, such as channels
+type Shareable interface {
+	Value
+	// IsShareable returns true if the value can be used by several isolates at once
+	IsShareable() bool
+}
+
+// IsShareable returns true if a value can be passed to another isolate, which runs on its own goroutine, see Shareable.
+// Immutable values are shareable: null, primitives, strings, ranges and tuples of shareable values.
+// Arrays, maps, objects and functions are not, since they could be changed by both isolates at once
+func IsShareable(v Value) bool {
+	if shareable, ok := v.(Shareable); ok {
+		return shareable.IsShareable()
+	}
+
+	switch v.Type() {
+	case TypeNull, TypeInt, TypeInt64, TypeFloat, TypeFloat64, TypeString, TypeBool, TypeRange:
+		return true
+	case TypeTuple:
+		for _, element := range v.(*Tuple).Values() {
+			if !IsShareable(element) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
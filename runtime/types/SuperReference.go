@@ -0,0 +1,47 @@
+package types
+
+// SuperReference is the value of 'super' inside a method of a class extending another class.
+// Its members are the methods and constructors of the superclass bound to the receiver,
+// so super.greet() calls the overridden method and super.init(...) runs the superclass constructor
+type SuperReference struct {
+	Receiver *Object
+	// Class is the superclass of the class declaring the method
+	Class *Class
+}
+
+// NewSuperReference creates a new SuperReference
+func NewSuperReference(receiver *Object, class *Class) *SuperReference {
+	return &SuperReference{
+		Receiver: receiver,
+		Class:    class,
+	}
+}
+
+func (s *SuperReference) Type() Type     { return TypeObject }
+func (s *SuperReference) String() string { return "<super " + s.Class.Name + ">" }
+func (s *SuperReference) IsTruthy() bool { return true }
+func (s *SuperReference) Clone() Value   { return s }
+func (s *SuperReference) Equals(other Value) bool {
+	o, ok := other.(*SuperReference)
+	return ok && o.Receiver == s.Receiver && o.Class == s.Class
+}
+
+// GetMember returns a method of the superclass bound to the receiver.
+// Fields are not members of super, they are accessed through 'this'
+func (s *SuperReference) GetMember(name string) (Value, error) {
+	if name == "init" {
+		if constructors := s.Class.InitOverloads(); len(constructors) > 0 {
+			return NewBoundMethod(s.Receiver, name, constructors), nil
+		}
+		return nil, NewTypeError("%s has no init constructor", s.Class.Name)
+	}
+	if method := s.Class.FindMethod(name); method != nil {
+		return NewBoundMethod(s.Receiver, name, []*UserFunction{method}), nil
+	}
+	if container, ok := s.Receiver.native.(HasMembers); ok {
+		if value, err := container.GetMember(name); err == nil {
+			return value, nil
+		}
+	}
+	return nil, NewTypeError("%s has no method '%s'", s.Class.Name, name)
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"zen/lang/parsing/ast"
+	"zen/runtime/environment"
+)
+
+// Trait is a set of fields and methods declared in zen code, which classes can be composed of with 'use'
+type Trait struct {
+	Name string
+	// Fields in declaration order
+	Fields []*ClassField
+	// Methods in declaration order, not including init. They are copied into the classes using the trait
+	Methods []*UserFunction
+	// Init is called on each new object of a class using the trait, or nil
+	Init *UserFunction
+	// Closure is the scope the trait was declared in
+	Closure *environment.Scope
+}
+
+// TraitUse is a trait a class is composed of, see Class.Traits
+type TraitUse struct {
+	Trait *Trait
+	// Arguments are evaluated for each new object and passed to Init
+	Arguments []ast.Expression
+	// Init is the init of the trait, bound to the class using it, or nil
+	Init *UserFunction
+}
+
+// NewTrait creates a new Trait without any fields or methods
+func NewTrait(name string, closure *environment.Scope) *Trait {
+	return &Trait{
+		Name:    name,
+		Fields:  make([]*ClassField, 0),
+		Methods: make([]*UserFunction, 0),
+		Closure: closure,
+	}
+}
+
+func (t *Trait) Type() Type     { return TypeTrait }
+func (t *Trait) String() string { return "<trait " + t.Name + ">" }
+func (t *Trait) IsTruthy() bool { return true }
+func (t *Trait) Clone() Value   { return t }
+func (t *Trait) Equals(other Value) bool {
+	o, ok := other.(*Trait)
+	return ok && o == t
+}
+
+// HasMember returns true if the trait declares a field or method with the given name, not including init
+func (t *Trait) HasMember(name string) bool {
+	for _, field := range t.Fields {
+		if field.Name == name {
+			return true
+		}
+	}
+	for _, method := range t.Methods {
+		if method.Name == name {
+			return true
+		}
+	}
+	return false
+}
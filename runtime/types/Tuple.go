@@ -0,0 +1,106 @@
+package types
+
+import (
+	"strings"
+)
+
+// Tuple is an immutable, ordered list of values, such as the values returned by a function returning multiple values.
+// The elements of a named tuple are accessed by name, e.g. result.statusCode
+type Tuple struct {
+	// names of the elements, nil for tuples that haven't been named by a type yet, see TypeHint.Names
+	names  []string
+	values []Value
+	// hints holds the declared types of the elements, nil for tuples that haven't been checked against a type
+	hints []*TypeHint
+}
+
+// NewTuple creates a tuple of the given values, named if names isn't nil
+func NewTuple(names []string, values []Value) *Tuple {
+	return &Tuple{names: names, values: values}
+}
+
+func (t *Tuple) Type() Type     { return TypeTuple }
+func (t *Tuple) IsTruthy() bool { return true }
+
+// Clone returns the tuple itself, since tuples are immutable
+func (t *Tuple) Clone() Value { return t }
+
+// Length returns the number of elements in the tuple
+func (t *Tuple) Length() int {
+	return len(t.values)
+}
+
+// Values returns the elements of the tuple, in order
+func (t *Tuple) Values() []Value {
+	return t.values
+}
+
+// Names returns the names of the elements of the tuple, nil if it is unnamed
+func (t *Tuple) Names() []string {
+	return t.names
+}
+
+// ElementType returns the declared type of the element at index i, or nil if the tuple has no declared types
+func (t *Tuple) ElementType(i int) *TypeHint {
+	if t.hints == nil {
+		return nil
+	}
+	return t.hints[i]
+}
+
+// String returns the tuple as a parenthesized list of its elements, e.g. (statusCode: 404, body: "not found")
+func (t *Tuple) String() string {
+	elements := make([]string, len(t.values))
+	for i, value := range t.values {
+		elements[i] = Inspect(value)
+		if t.names != nil {
+			elements[i] = t.names[i] + ": " + elements[i]
+		}
+	}
+	return "(" + strings.Join(elements, ", ") + ")"
+}
+
+// Equals returns true if the other value is a tuple with equal elements, in the same order
+func (t *Tuple) Equals(other Value) bool {
+	o, ok := other.(*Tuple)
+	if !ok || len(o.values) != len(t.values) {
+		return false
+	}
+	for i, value := range t.values {
+		if !value.Equals(o.values[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Iterator returns an iterator over the name and value of each element, or the index for unnamed tuples
+func (t *Tuple) Iterator() Iterator {
+	keys := make([]Value, len(t.values))
+	for i := range t.values {
+		if t.names != nil {
+			keys[i] = NewString(t.names[i])
+		} else {
+			keys[i] = NewInt(int32(i))
+		}
+	}
+	return &sliceIterator{keys: keys, values: t.values}
+}
+
+// GetMember returns the element with the given name, or the length of the tuple
+func (t *Tuple) GetMember(name string) (Value, error) {
+	for i, elementName := range t.names {
+		if elementName == name {
+			return t.values[i], nil
+		}
+	}
+	if name == "length" {
+		return NewInt(int32(len(t.values))), nil
+	}
+	return nil, NewTypeError("Tuple %s has no member '%s'", t, name)
+}
+
+// SetMember always fails, since tuples are immutable
+func (t *Tuple) SetMember(name string, value Value) error {
+	return NewTypeError("Cannot assign to '%s', tuples are immutable", name)
+}
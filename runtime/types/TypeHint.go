@@ -0,0 +1,567 @@
+package types
+
+import (
+	"strconv"
+	"strings"
+)
+
+// TypeHint is the runtime representation of a declared type,
+// such as a variable's type annotation or a function's parameter and return types
+type TypeHint struct {
+	// Name of the type, e.g. "int", "string", "any", "func", "Array" or "Map"
+	Name     string
+	Nullable bool
+	// Arguments holds the type arguments of a parametric type, e.g. int in Array<int, 3> or string, int in Map<string, int>
+	Arguments []*TypeHint
+	// Size is the capacity of an Array type, or Unbounded
+	Size int
+	// Parameters holds the parameter types of a function type
+	Parameters []*TypeHint
+	// Returns is the return type of a function type
+	Returns *TypeHint
+	// Class is the class of an object type, e.g. Person
+	Class *Class
+	// Interface is the interface of an interface type, e.g. Drawable
+	Interface *Interface
+	// TypeArguments holds the arguments of a generic class type, e.g. int in Box<int>, see TypeValue
+	TypeArguments []Value
+	// Parameter is the type parameter an unbound placeholder stands for, which accepts any value
+	Parameter *TypeParameter
+	// Names holds the names of the elements of a tuple type, whose types are in Arguments
+	Names []string
+	// BoundTo is the type parameter the type was given for, which errors checking against the type refer to
+	BoundTo *TypeParameter
+}
+
+// NewTypeHint creates a new TypeHint
+func NewTypeHint(name string, nullable bool) *TypeHint {
+	return &TypeHint{
+		Name:     name,
+		Nullable: nullable,
+	}
+}
+
+// NewInterfaceTypeHint creates a TypeHint for objects of classes implementing an interface
+func NewInterfaceTypeHint(iface *Interface, nullable bool) *TypeHint {
+	return &TypeHint{
+		Name:      iface.Name,
+		Nullable:  nullable,
+		Interface: iface,
+	}
+}
+
+// NewClassTypeHint creates a TypeHint for instances of a class
+func NewClassTypeHint(class *Class, nullable bool) *TypeHint {
+	return &TypeHint{
+		Name:     class.Name,
+		Nullable: nullable,
+		Class:    class,
+	}
+}
+
+// NewUnionTypeHint creates a TypeHint for a value that can be any one of several types, e.g. string | int
+func NewUnionTypeHint(members []*TypeHint, nullable bool) *TypeHint {
+	return &TypeHint{
+		Name:      "union",
+		Nullable:  nullable,
+		Arguments: members,
+	}
+}
+
+// NewFunctionTypeHint creates a TypeHint for a function type, e.g. func(int, int): int
+func NewFunctionTypeHint(parameters []*TypeHint, returns *TypeHint, nullable bool) *TypeHint {
+	return &TypeHint{
+		Name:       "func",
+		Nullable:   nullable,
+		Parameters: parameters,
+		Returns:    returns,
+	}
+}
+
+// NewArrayTypeHint creates a TypeHint for an array type, e.g. Array<int, 3>
+func NewArrayTypeHint(element *TypeHint, size int, nullable bool) *TypeHint {
+	return &TypeHint{
+		Name:      "Array",
+		Nullable:  nullable,
+		Arguments: []*TypeHint{element},
+		Size:      size,
+	}
+}
+
+// NewMapTypeHint creates a TypeHint for a map type, e.g. Map<string, int>
+func NewMapTypeHint(key *TypeHint, value *TypeHint, nullable bool) *TypeHint {
+	return &TypeHint{
+		Name:      "Map",
+		Nullable:  nullable,
+		Arguments: []*TypeHint{key, value},
+	}
+}
+
+// NewTupleTypeHint creates a TypeHint for the named values returned by a function, e.g. statusCode: int, body: string
+func NewTupleTypeHint(names []string, elements []*TypeHint, nullable bool) *TypeHint {
+	return &TypeHint{
+		Name:      "Tuple",
+		Nullable:  nullable,
+		Arguments: elements,
+		Names:     names,
+	}
+}
+
+// BindTo returns a copy of the hint given as the argument of a type parameter, see BoundTo
+func (h *TypeHint) BindTo(param *TypeParameter) *TypeHint {
+	bound := *h
+	bound.BoundTo = param
+	return &bound
+}
+
+// WithNullable returns the hint, or a nullable copy of it if nullable is true and it isn't nullable already
+func (h *TypeHint) WithNullable(nullable bool) *TypeHint {
+	if !nullable || h.Nullable {
+		return h
+	}
+	copied := *h
+	copied.Nullable = true
+	return &copied
+}
+
+// acceptsAnything returns true for any and unbound type parameters
+func (h *TypeHint) acceptsAnything() bool {
+	return h.Name == "any" || h.Parameter != nil
+}
+
+// String returns the type as it would be written in zen code, e.g. "string?"
+func (h *TypeHint) String() string {
+	str := h.Name
+	if h.IsUnion() {
+		members := make([]string, len(h.Arguments))
+		for i, member := range h.Arguments {
+			members[i] = member.String()
+		}
+		str = strings.Join(members, " | ")
+	} else if h.Name == "func" {
+		params := make([]string, len(h.Parameters))
+		for i, param := range h.Parameters {
+			params[i] = param.String()
+		}
+		str = "func(" + strings.Join(params, ", ") + "): " + h.Returns.String()
+	} else if h.Name == "Tuple" {
+		elements := make([]string, len(h.Arguments))
+		for i, element := range h.Arguments {
+			elements[i] = h.Names[i] + ": " + element.String()
+		}
+		str = "(" + strings.Join(elements, ", ") + ")"
+	} else if len(h.Arguments) > 0 {
+		args := make([]string, len(h.Arguments))
+		for i, arg := range h.Arguments {
+			args[i] = arg.String()
+		}
+		if h.Name == "Array" && h.Size != Unbounded {
+			args = append(args, strconv.Itoa(h.Size))
+		}
+		str += "<" + strings.Join(args, ", ") + ">"
+	} else if len(h.TypeArguments) > 0 {
+		str += "<" + FormatTypeArguments(h.TypeArguments) + ">"
+	}
+
+	if h.Nullable {
+		return str + "?"
+	}
+	return str
+}
+
+// IsVoid returns true if the hint denotes the absence of a value
+func (h *TypeHint) IsVoid() bool {
+	return h.Name == "void"
+}
+
+// IsUnion returns true if the hint is a union of several types, see NewUnionTypeHint
+func (h *TypeHint) IsUnion() bool {
+	return h.Name == "union"
+}
+
+// Check verifies that a value satisfies the type hint.
+// Numeric values are converted to the hinted numeric type when no precision is lost,
+// so an int64 literal can be passed where an int is expected as long as it fits.
+// Returns the (possibly converted) value.
+// Errors checking against a type given for a type parameter name the parameter, e.g. (type parameter T of 'Box')
+func (h *TypeHint) Check(v Value) (Value, error) {
+	converted, err := h.check(v)
+	if typeErr, ok := err.(*TypeError); ok && h.BoundTo != nil {
+		return nil, NewTypeError("%s (%s)", typeErr.Message, h.BoundTo)
+	}
+	return converted, err
+}
+
+// check verifies that a value satisfies the type hint, see Check
+func (h *TypeHint) check(v Value) (Value, error) {
+	if h.Parameter != nil {
+		return v, nil
+	}
+
+	if v.Type() == TypeNull {
+		if h.Nullable || h.Name == "void" {
+			return v, nil
+		}
+		return nil, NewTypeError("expected %s, got null", h)
+	}
+
+	if h.Class != nil {
+		if obj, ok := v.(*Object); ok && obj.Class.IsSubclassOf(h.Class) && h.matchesTypeArguments(obj) {
+			return v, nil
+		}
+		return nil, NewTypeError("expected %s, got %s", h, describe(v))
+	}
+
+	// Interfaces are nominal: the class of the object must declare to implement the interface
+	if h.Interface != nil {
+		if obj, ok := v.(*Object); ok && obj.Class.Implements(h.Interface) {
+			return v, nil
+		}
+		return nil, NewTypeError("expected %s, got %s", h, describe(v))
+	}
+
+	switch h.Name {
+	case "any":
+		return v, nil
+	case "void":
+		return nil, NewTypeError("expected void, got %s", v.Type())
+	case "int", "int64":
+		if v.Type() != TypeInt && v.Type() != TypeInt64 {
+			return nil, NewTypeError("expected %s, got %s", h, v.Type())
+		}
+		return convertHinted(v, h)
+	case "float", "float64":
+		if !IsNumeric(v.Type()) {
+			return nil, NewTypeError("expected %s, got %s", h, v.Type())
+		}
+		return convertHinted(v, h)
+	case "string":
+		if v.Type() != TypeString {
+			return nil, NewTypeError("expected %s, got %s", h, v.Type())
+		}
+		return v, nil
+	case "bool":
+		if v.Type() != TypeBool {
+			return nil, NewTypeError("expected %s, got %s", h, v.Type())
+		}
+		return v, nil
+	case "func":
+		return h.checkFunction(v)
+	case "Array", "Map":
+		// Views are checked as the collection of their matching elements
+		collected, err := CollectView(v)
+		if err != nil {
+			return nil, err
+		}
+		if h.Name == "Map" {
+			return h.checkMap(collected)
+		}
+		return h.checkArray(collected)
+	case "Tuple":
+		return h.checkTuple(v)
+	case "Range":
+		if v.Type() != TypeRange {
+			return nil, NewTypeError("expected %s, got %s", h, v.Type())
+		}
+		return v, nil
+	case "Promise":
+		// The value of a promise isn't known until it settles, so Promise<T> accepts any promise
+		if v.Type() != TypePromise {
+			return nil, NewTypeError("expected %s, got %s", h, v.Type())
+		}
+		return v, nil
+	case "Timer":
+		if v.Type() != TypeTimer {
+			return nil, NewTypeError("expected %s, got %s", h, v.Type())
+		}
+		return v, nil
+	case "Channel":
+		return h.checkChannel(v)
+	case "union":
+		for _, member := range h.Arguments {
+			if converted, err := member.Check(v); err == nil {
+				return converted, nil
+			}
+		}
+		return nil, NewTypeError("expected %s, got %s", h, v.Type())
+	}
+
+	return nil, NewTypeError("unknown type %s", h.Name)
+}
+
+// Matches returns true if a value is of the hinted type, as tested by `is` in when statements.
+// Unlike Check, it never converts or constrains the value: integers only match integer types they fit in,
+// floats only match float types and untyped arrays and maps match if all their elements do
+func (h *TypeHint) Matches(v Value) bool {
+	if v.Type() == TypeNull {
+		return h.Nullable || h.acceptsAnything()
+	}
+
+	if view, ok := v.(*View); ok {
+		collected, err := view.Collect()
+		if err != nil {
+			return false
+		}
+		v = collected
+	}
+
+	switch h.Name {
+	case "any":
+		return true
+	case "int", "int64":
+		if v.Type() != TypeInt && v.Type() != TypeInt64 {
+			return false
+		}
+	case "float", "float64":
+		if v.Type() != TypeFloat && v.Type() != TypeFloat64 {
+			return false
+		}
+	case "union":
+		for _, member := range h.Arguments {
+			if member.Matches(v) {
+				return true
+			}
+		}
+		return false
+	case "Array":
+		arr, ok := Unwrap(v).(*Array)
+		if !ok {
+			return false
+		}
+		if arr.IsUntyped() {
+			if h.Size != Unbounded && arr.Length() > h.Size {
+				return false
+			}
+			for _, element := range arr.Elements() {
+				if !h.Arguments[0].Matches(element) {
+					return false
+				}
+			}
+			return true
+		}
+	case "Map":
+		m, ok := Unwrap(v).(*Map)
+		if !ok {
+			return false
+		}
+		if m.IsUntyped() {
+			for _, entry := range m.Entries() {
+				if !h.Arguments[0].Matches(entry.Key) || !h.Arguments[1].Matches(entry.Value) {
+					return false
+				}
+			}
+			return true
+		}
+	}
+
+	_, err := h.Check(v)
+	return err == nil
+}
+
+// matchesTypeArguments returns true if an object of a generic class was created with the type arguments of the hint.
+// Hints without type arguments, e.g. Box, match objects of any type arguments
+func (h *TypeHint) matchesTypeArguments(obj *Object) bool {
+	if len(h.TypeArguments) == 0 {
+		return true
+	}
+	actual := obj.TypeArguments(h.Class)
+	if len(actual) != len(h.TypeArguments) {
+		return false
+	}
+	for i, expected := range h.TypeArguments {
+		if !typeArgumentMatches(expected, actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkChannel verifies that a value is a channel of the element type of the hint, unless the hint is just Channel
+func (h *TypeHint) checkChannel(v Value) (Value, error) {
+	channel, ok := v.(interface{ TypeHint() *TypeHint })
+	if v.Type() != TypeChannel || !ok {
+		return nil, NewTypeError("expected %s, got %s", h, v.Type())
+	}
+	if len(h.Arguments) == 0 || h.Arguments[0].acceptsAnything() {
+		return v, nil
+	}
+	if actual := channel.TypeHint(); h.Arguments[0].String() != actual.Arguments[0].String() {
+		return nil, NewTypeError("expected %s, got %s", h, actual)
+	}
+	return v, nil
+}
+
+// checkFunction verifies that a value is callable with the number of parameters of the function type
+func (h *TypeHint) checkFunction(v Value) (Value, error) {
+	var paramCount int
+	switch fn := v.(type) {
+	case *UserFunction:
+		paramCount = len(fn.Parameters)
+	case *BuiltinFunction:
+		paramCount = len(fn.Parameters)
+	default:
+		return nil, NewTypeError("expected %s, got %s", h, v.Type())
+	}
+
+	if paramCount != len(h.Parameters) {
+		return nil, NewTypeError("expected %s, got a function with %d parameter(s)", h, paramCount)
+	}
+	return v, nil
+}
+
+// checkArray verifies that a value is an array with a compatible element type and capacity.
+// Untyped arrays, such as array literals, take on the element type and capacity of the hint.
+// Objects of classes extending Array are accepted if the array they wrap is
+func (h *TypeHint) checkArray(v Value) (Value, error) {
+	if obj, ok := v.(*Object); ok && obj.native != nil {
+		if _, err := h.checkArray(obj.native); err != nil {
+			return nil, NewTypeError("expected %s, got %s", h, obj.Class.Name)
+		}
+		return obj, nil
+	}
+
+	arr, ok := v.(*Array)
+	if !ok {
+		return nil, NewTypeError("expected %s, got %s", h, v.Type())
+	}
+
+	element := h.Arguments[0]
+	if arr.IsUntyped() {
+		if err := arr.Constrain(element, h.Size); err != nil {
+			return nil, err
+		}
+		return arr, nil
+	}
+
+	if !element.acceptsAnything() && element.String() != arr.ElementType.String() {
+		return nil, NewTypeError("expected %s, got %s", h, arr.TypeHint())
+	}
+	if h.Size != Unbounded && h.Size != arr.Capacity {
+		return nil, NewTypeError("expected %s, got %s", h, arr.TypeHint())
+	}
+	return arr, nil
+}
+
+// checkMap verifies that a value is a map with compatible key and value types.
+// Untyped maps, such as map literals, take on the key and value types of the hint.
+// Objects of classes extending Map are accepted if the map they wrap is
+func (h *TypeHint) checkMap(v Value) (Value, error) {
+	if obj, ok := v.(*Object); ok && obj.native != nil {
+		if _, err := h.checkMap(obj.native); err != nil {
+			return nil, NewTypeError("expected %s, got %s", h, obj.Class.Name)
+		}
+		return obj, nil
+	}
+
+	m, ok := v.(*Map)
+	if !ok {
+		return nil, NewTypeError("expected %s, got %s", h, v.Type())
+	}
+
+	key, value := h.Arguments[0], h.Arguments[1]
+	if m.IsUntyped() {
+		if err := m.Constrain(key, value); err != nil {
+			return nil, err
+		}
+		return m, nil
+	}
+
+	if !key.acceptsAnything() && key.String() != m.KeyType.String() {
+		return nil, NewTypeError("expected %s, got %s", h, m.TypeHint())
+	}
+	if !value.acceptsAnything() && value.String() != m.ValueType.String() {
+		return nil, NewTypeError("expected %s, got %s", h, m.TypeHint())
+	}
+	return m, nil
+}
+
+// checkTuple verifies that a value is a tuple with as many elements as the tuple type, each of the type of the element.
+// Returns a tuple of the converted elements, named after the elements of the type
+func (h *TypeHint) checkTuple(v Value) (Value, error) {
+	tuple, ok := v.(*Tuple)
+	if !ok {
+		return nil, NewTypeError("expected %d values %s, got %s", len(h.Arguments), h, describe(v))
+	}
+	if tuple.Length() != len(h.Arguments) {
+		return nil, NewTypeError("expected %d values %s, got %d", len(h.Arguments), h, tuple.Length())
+	}
+
+	values := make([]Value, len(h.Arguments))
+	for i, element := range h.Arguments {
+		if tuple.names != nil && tuple.names[i] != h.Names[i] {
+			return nil, NewTypeError("expected %s, got %s", h, tuple)
+		}
+		converted, err := element.Check(tuple.values[i])
+		if err != nil {
+			return nil, NewTypeError("%s: %s", h.Names[i], err.(*TypeError).Message)
+		}
+		values[i] = converted
+	}
+	checked := NewTuple(h.Names, values)
+	checked.hints = h.Arguments
+	return checked, nil
+}
+
+// describe returns the type of a value for error messages, which is the class name for objects, e.g. Box<int>
+func describe(v Value) string {
+	if obj, ok := v.(*Object); ok {
+		return obj.TypeName()
+	}
+	return v.Type().String()
+}
+
+// ZeroValue returns the value new elements of this type are initialized with:
+// 0 for numbers, "" for strings, false for booleans, empty arrays for arrays, empty maps for maps,
+// the zero value of the first member for unions and null for anything else
+func (h *TypeHint) ZeroValue() Value {
+	if h.Nullable {
+		return NewNull()
+	}
+
+	switch h.Name {
+	case "int":
+		return NewInt(0)
+	case "int64":
+		return NewInt64(0)
+	case "float":
+		return NewFloat(0)
+	case "float64":
+		return NewFloat64(0)
+	case "string":
+		return NewString("")
+	case "bool":
+		return NewBool(false)
+	case "Array":
+		return NewArray(nil, h.Arguments[0], h.Size)
+	case "Map":
+		return NewMap(h.Arguments[0], h.Arguments[1])
+	case "union":
+		return h.Arguments[0].ZeroValue()
+	}
+	return NewNull()
+}
+
+// convertHinted converts a numeric value to the primitive type named by the hint
+func convertHinted(v Value, h *TypeHint) (Value, error) {
+	target := primitiveTypes[h.Name]
+	if v.Type() == target {
+		return v, nil
+	}
+	return Convert(v, target)
+}
+
+// primitiveTypes maps the names of primitive type keywords to their Type
+var primitiveTypes = map[string]Type{
+	"int":     TypeInt,
+	"int64":   TypeInt64,
+	"float":   TypeFloat,
+	"float64": TypeFloat64,
+	"string":  TypeString,
+	"bool":    TypeBool,
+}
+
+// IsPrimitiveTypeName returns true if name is one of the primitive type keywords, "any" or "void"
+func IsPrimitiveTypeName(name string) bool {
+	_, ok := primitiveTypes[name]
+	return ok || name == "any" || name == "void"
+}
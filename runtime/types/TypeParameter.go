@@ -0,0 +1,131 @@
+package types
+
+import (
+	"strings"
+)
+
+// TypeParameter is a type parameter of a generic class or function, e.g. T in class Box<T>
+type TypeParameter struct {
+	Name string
+	// Integer is true for value parameters declared as N: int, which only take integers.
+	// Parameters declared without a type take either a type or an integer, e.g. S in class Map<K, V, S>
+	Integer bool
+	// Owner is the name of the class or function declaring the parameter
+	Owner string
+}
+
+// NewTypeParameter creates a new TypeParameter
+func NewTypeParameter(name string, integer bool, owner string) *TypeParameter {
+	return &TypeParameter{
+		Name:    name,
+		Integer: integer,
+		Owner:   owner,
+	}
+}
+
+// String describes the parameter for error messages, e.g. type parameter T of 'Box'
+func (p *TypeParameter) String() string {
+	return "type parameter " + p.Name + " of '" + p.Owner + "'"
+}
+
+// TypeValue is a type given as the argument of a type parameter, e.g. int in Box<int>.
+// Type parameters are bound to a TypeValue in the scope of a generic class or function, integers to an int.
+// While a generic class or function is declared, its type parameters are bound to a placeholder accepting any value
+type TypeValue struct {
+	Hint *TypeHint
+}
+
+// NewTypeValue creates a new TypeValue
+func NewTypeValue(hint *TypeHint) *TypeValue {
+	return &TypeValue{Hint: hint}
+}
+
+// NewTypePlaceholder creates the TypeValue an unbound type parameter stands for, see TypeHint.Parameter
+func NewTypePlaceholder(param *TypeParameter) *TypeValue {
+	return &TypeValue{Hint: &TypeHint{Name: param.Name, Parameter: param}}
+}
+
+func (t *TypeValue) Type() Type     { return TypeType }
+func (t *TypeValue) String() string { return t.Hint.String() }
+func (t *TypeValue) IsTruthy() bool { return true }
+func (t *TypeValue) Clone() Value   { return t }
+func (t *TypeValue) Equals(other Value) bool {
+	o, ok := other.(*TypeValue)
+	return ok && o.Hint.String() == t.Hint.String()
+}
+
+// FormatTypeArguments returns type arguments as they are written, e.g. int, 3
+func FormatTypeArguments(args []Value) string {
+	strs := make([]string, len(args))
+	for i, arg := range args {
+		if arg.Type() == TypeInt && arg.(*Int).Value() == Unbounded {
+			strs[i] = "INF"
+			continue
+		}
+		strs[i] = arg.String()
+	}
+	return strings.Join(strs, ", ")
+}
+
+// typeArgumentMatches returns true if a type argument of a value satisfies the one of a hint:
+// types must be the same unless the hint's is any or an unbound type parameter, and integers must be equal
+func typeArgumentMatches(expected Value, actual Value) bool {
+	if hint, ok := expected.(*TypeValue); ok {
+		if hint.Hint.acceptsAnything() {
+			return true
+		}
+		return actual.Type() == TypeType && actual.(*TypeValue).Hint.String() == hint.Hint.String()
+	}
+	return actual.Type() == TypeInt && expected.Equals(actual)
+}
+
+// HintOf returns the type of a value, used to infer the type arguments of a generic class or function from its arguments.
+// Untyped arrays and maps are of the type all their elements share, or any
+func HintOf(v Value) *TypeHint {
+	switch val := v.(type) {
+	case *Object:
+		hint := NewClassTypeHint(val.Class, false)
+		hint.TypeArguments = val.TypeArguments(val.Class)
+		return hint
+	case *Array:
+		if !val.IsUntyped() {
+			return val.TypeHint()
+		}
+		return NewArrayTypeHint(commonHint(val.Elements()), Unbounded, false)
+	case *View:
+		if collected, err := val.Collect(); err == nil {
+			return HintOf(collected)
+		}
+		return HintOf(val.Collection())
+	case *Map:
+		if !val.IsUntyped() {
+			return val.TypeHint()
+		}
+		keys := make([]Value, 0)
+		values := make([]Value, 0)
+		for _, entry := range val.Entries() {
+			keys = append(keys, entry.Key)
+			values = append(values, entry.Value)
+		}
+		return NewMapTypeHint(commonHint(keys), commonHint(values), false)
+	}
+
+	if _, ok := primitiveTypes[v.Type().String()]; ok || v.Type() == TypeRange {
+		return NewTypeHint(v.Type().String(), false)
+	}
+	return NewTypeHint("any", false)
+}
+
+// commonHint returns the type shared by all values, or any if they differ or there are none
+func commonHint(values []Value) *TypeHint {
+	if len(values) == 0 {
+		return NewTypeHint("any", false)
+	}
+	hint := HintOf(values[0])
+	for _, v := range values[1:] {
+		if HintOf(v).String() != hint.String() {
+			return NewTypeHint("any", false)
+		}
+	}
+	return hint
+}
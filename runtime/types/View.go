@@ -0,0 +1,162 @@
+package types
+
+// View is a lazy filter over the elements of an Array or Map, e.g. people where value{"age"} > 20.
+// The condition is evaluated for one element at a time while the view is iterated, never ahead of time,
+// so a view always reflects the current elements of the collection it filters.
+// Any other use of the view acts on a new collection of the matching elements, holding references, not copies, see Collect
+type View struct {
+	// source is the filtered Array or Map, or another view
+	source Value
+	// matches evaluates the condition for one element
+	matches func(key Value, value Value) (bool, error)
+}
+
+// NewView creates a view of the elements of an Array, Map or View for which matches returns true
+func NewView(source Value, matches func(key Value, value Value) (bool, error)) *View {
+	return &View{source: source, matches: matches}
+}
+
+func (v *View) Type() Type     { return TypeView }
+func (v *View) IsTruthy() bool { return true }
+func (v *View) Clone() Value   { return v }
+
+// String returns the matching elements as the collection they are collected in, e.g. ["a", "b"].
+// Evaluating the condition may fail, which Stringify reports, while String can only fall back to describing the view
+func (v *View) String() string {
+	collected, err := v.Collect()
+	if err != nil {
+		return "<view of " + v.Collection().String() + ">"
+	}
+	return collected.String()
+}
+
+// Equals returns true if the matching elements equal those of the other collection or view
+func (v *View) Equals(other Value) bool {
+	if view, ok := other.(*View); ok {
+		if view == v {
+			return true
+		}
+		collected, err := view.Collect()
+		if err != nil {
+			return false
+		}
+		other = collected
+	}
+	collected, err := v.Collect()
+	return err == nil && collected.Equals(other)
+}
+
+// Collection returns the Array or Map the view filters, looking through the views it is a view of
+func (v *View) Collection() Value {
+	if view, ok := v.source.(*View); ok {
+		return view.Collection()
+	}
+	return v.source
+}
+
+// IsMap returns true for views of a Map, whose elements are identified by key rather than index
+func (v *View) IsMap() bool {
+	return v.Collection().Type() == TypeMap
+}
+
+// Iterator returns an iterator over the matching elements, with their index or key in the filtered collection.
+// Evaluating the condition may fail, which ends the iteration, see FallibleIterator
+func (v *View) Iterator() Iterator {
+	return &viewIterator{source: v.source.(Iterable).Iterator(), matches: v.matches}
+}
+
+// Collect returns a new Array or Map, of the type of the filtered collection, holding the elements that currently match
+func (v *View) Collect() (Value, error) {
+	it := v.Iterator().(*viewIterator)
+	switch collection := v.Collection().(type) {
+	case *Array:
+		elements := make([]Value, 0)
+		for _, value, ok := it.Next(); ok; _, value, ok = it.Next() {
+			elements = append(elements, value)
+		}
+		if it.err != nil {
+			return nil, it.err
+		}
+		return NewArray(elements, collection.ElementType, Unbounded), nil
+	case *Map:
+		result := NewMap(collection.KeyType, collection.ValueType)
+		for key, value, ok := it.Next(); ok; key, value, ok = it.Next() {
+			result.put(key, value)
+		}
+		if it.err != nil {
+			return nil, it.err
+		}
+		return result, nil
+	}
+	return nil, NewTypeError("cannot collect a view of %s", v.Collection().Type())
+}
+
+// viewMutators are the members of collections that modify them, which views don't have:
+// a view only reads the collection it filters, and the collected elements are a new collection each time
+var viewMutators = map[string]bool{"append": true, "reverse": true, "resize": true}
+
+// GetMember returns the members of the collection of matching elements, e.g. length or has.
+// Members modifying the collection are an error, see viewMutators
+func (v *View) GetMember(name string) (Value, error) {
+	if viewMutators[name] {
+		return nil, NewTypeError("cannot call '%s' on a view of %s, a view only reads the collection it filters", name, v.Collection().Type())
+	}
+	collected, err := v.Collect()
+	if err != nil {
+		return nil, err
+	}
+	return collected.(HasMembers).GetMember(name)
+}
+
+// TypeHint returns the type of the filtered collection, e.g. Array<int>
+func (v *View) TypeHint() *TypeHint {
+	return HintOf(v.Collection())
+}
+
+// viewIterator skips the elements of another iterator for which the condition of a view doesn't hold
+type viewIterator struct {
+	source  Iterator
+	matches func(key Value, value Value) (bool, error)
+	// err is set when evaluating the condition fails, which ends the iteration
+	err error
+}
+
+func (it *viewIterator) Next() (Value, Value, bool) {
+	for it.err == nil {
+		key, value, ok := it.source.Next()
+		if !ok {
+			return nil, nil, false
+		}
+
+		matches, err := it.matches(key, value)
+		if err != nil {
+			it.err = err
+			break
+		}
+		if matches {
+			return key, value, true
+		}
+	}
+	return nil, nil, false
+}
+
+func (it *viewIterator) Err() error {
+	return it.err
+}
+
+// Stringify returns the string representation of a value, like String, or the error evaluating the condition of a view fails with
+func Stringify(v Value) (string, error) {
+	collected, err := CollectView(v)
+	if err != nil {
+		return "", err
+	}
+	return collected.String(), nil
+}
+
+// CollectView returns the collection of matching elements of a view, or the value itself otherwise
+func CollectView(v Value) (Value, error) {
+	if view, ok := v.(*View); ok {
+		return view.Collect()
+	}
+	return v, nil
+}
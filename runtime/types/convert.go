@@ -1,11 +1,17 @@
 package types
 
 import (
+	"math"
 	"strconv"
 )
 
 // Convert attempts to convert a value to the specified type
 func Convert(v Value, to Type) (Value, error) {
+	// Any type is accepted as-is
+	if to == TypeAny {
+		return v, nil
+	}
+
 	// Same type, just return a clone
 	if v.Type() == to {
 		return v.Clone(), nil
@@ -60,12 +66,12 @@ func convertToInt(v Value) (Value, error) {
 	case *Float:
 		return NewInt(int32(val.Value())), nil
 	case *Int64:
-		if val.Value() > int64(^int32(0)) || val.Value() < int64(-int32(^uint32(0)>>1)-1) {
+		if val.Value() > math.MaxInt32 || val.Value() < math.MinInt32 {
 			return nil, NewTypeError("int64 value %d out of range for int32", val.Value())
 		}
 		return NewInt(int32(val.Value())), nil
 	case *Float64:
-		if val.Value() > float64(^int32(0)) || val.Value() < float64(-int32(^uint32(0)>>1)-1) {
+		if val.Value() > math.MaxInt32 || val.Value() < math.MinInt32 {
 			return nil, NewTypeError("float64 value %f out of range for int32", val.Value())
 		}
 		return NewInt(int32(val.Value())), nil
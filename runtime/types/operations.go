@@ -1,10 +1,47 @@
 package types
 
-// BinaryOp performs a binary operation on two values
-func BinaryOp(left, right Value, op string) (Value, error) {
+// OperatorMethods maps the binary operators a class can overload to the method implementing them,
+// which is called on the left operand with the right operand, e.g. a + b calls a._add(b).
+// a != b negates a._equals(b)
+var OperatorMethods = map[string]string{
+	"+":  "_add",
+	"-":  "_subtract",
+	"*":  "_multiply",
+	"/":  "_divide",
+	"<":  "_lessThan",
+	"<=": "_lessEqual",
+	">":  "_greaterThan",
+	">=": "_greaterEqual",
+	"==": "_equals",
+	"!=": "_equals",
+}
+
+// UnaryOperatorMethods maps the unary operators a class can overload to the method implementing them, e.g. -a calls a._negate()
+var UnaryOperatorMethods = map[string]string{
+	"-": "_negate",
+}
+
+// MethodCaller calls a method bound to an object with already evaluated arguments.
+// Operations on objects implementing operator methods use it to call into zen code
+type MethodCaller func(method *BoundMethod, args []Value) (Value, error)
+
+// BinaryOp performs a binary operation on two values.
+// If the left operand is an object whose class implements the operator's method, see OperatorMethods,
+// the method is called with call. Comparing an object to null never calls _equals
+func BinaryOp(left, right Value, op string, call MethodCaller) (Value, error) {
+	if method := operatorMethod(left, OperatorMethods[op]); method != nil && call != nil {
+		if (op == "==" || op == "!=") && right.Type() == TypeNull {
+			return NewBool(op == "!="), nil
+		}
+		return callOperatorMethod(method, op, []Value{right}, call)
+	}
+
 	// First check if the operation is valid for these types
 	if !IsValidBinaryOp(left.Type(), right.Type(), op) {
-		return nil, NewTypeError("invalid operation: %s %s %s", left.Type(), op, right.Type())
+		if object, ok := left.(*Object); ok && OperatorMethods[op] != "" {
+			return nil, NewTypeError("invalid operation: %s %s %s, %s has no %s method", describe(left), op, describe(right), object.Class.Name, OperatorMethods[op])
+		}
+		return nil, NewTypeError("invalid operation: %s %s %s", describe(left), op, describe(right))
 	}
 
 	// For logical operators, both operands must be boolean
@@ -54,11 +91,17 @@ func BinaryOp(left, right Value, op string) (Value, error) {
 	}
 }
 
-// UnaryOp performs a unary operation on a value
-func UnaryOp(v Value, op string) (Value, error) {
+// UnaryOp performs a unary operation on a value.
+// If the operand is an object whose class implements the operator's method, see UnaryOperatorMethods,
+// the method is called with call
+func UnaryOp(v Value, op string, call MethodCaller) (Value, error) {
+	if method := operatorMethod(v, UnaryOperatorMethods[op]); method != nil && call != nil {
+		return callOperatorMethod(method, op, []Value{}, call)
+	}
+
 	// First check if the operation is valid for this type
 	if !IsValidUnaryOp(v.Type(), op) {
-		return nil, NewTypeError("invalid operation: %s %s", op, v.Type())
+		return nil, NewTypeError("invalid operation: %s %s", op, describe(v))
 	}
 
 	switch op {
@@ -74,6 +117,36 @@ func UnaryOp(v Value, op string) (Value, error) {
 	}
 }
 
+// operatorMethod returns the method with the given name bound to v if v is an object whose class declares it, or nil
+func operatorMethod(v Value, name string) *BoundMethod {
+	object, ok := v.(*Object)
+	if !ok || name == "" {
+		return nil
+	}
+	return object.Method(name)
+}
+
+// callOperatorMethod calls the method implementing an operator.
+// Methods implementing comparisons must return a bool, which is negated for !=
+func callOperatorMethod(method *BoundMethod, op string, args []Value, call MethodCaller) (Value, error) {
+	result, err := call(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op {
+	case "==", "!=", "<", "<=", ">", ">=":
+		b, ok := result.(*Bool)
+		if !ok {
+			return nil, NewTypeError("%s.%s must return bool, got %s", method.Receiver.Class.Name, method.Name, result.Type())
+		}
+		if op == "!=" {
+			return NewBool(!b.Value()), nil
+		}
+	}
+	return result, nil
+}
+
 // Helper functions for operations
 
 func add(l, r Value) (Value, error) {
@@ -125,10 +198,13 @@ func multiply(l, r Value) (Value, error) {
 	}
 }
 
+// ErrDivisionByZero is the error of dividing by zero, which is caught as a DivisionByZeroException
+var ErrDivisionByZero = &TypeError{Message: "division by zero"}
+
 func divide(l, r Value) (Value, error) {
 	// Check for division by zero
 	if !r.IsTruthy() {
-		return nil, NewTypeError("division by zero")
+		return nil, ErrDivisionByZero
 	}
 
 	switch l.Type() {
@@ -2,6 +2,7 @@ package types
 
 import (
 	"strconv"
+	"unicode/utf8"
 )
 
 // Int represents a 32-bit integer value
@@ -109,6 +110,14 @@ func (s *String) Equals(other Value) bool {
 	return s.value == other.(*String).value
 }
 
+// GetMember returns the properties of the string (length, the number of characters)
+func (s *String) GetMember(name string) (Value, error) {
+	if name == "length" {
+		return NewInt(int32(utf8.RuneCountInString(s.value))), nil
+	}
+	return nil, NewTypeError("string has no member '%s'", name)
+}
+
 // Bool represents a boolean value
 type Bool struct {
 	value bool
@@ -150,6 +159,9 @@ func FromGoValue(v interface{}) (Value, error) {
 	switch val := v.(type) {
 	case nil:
 		return NewNull(), nil
+	case Value:
+		// Non-primitive values (functions, collections, objects) are stored as-is
+		return val, nil
 	case bool:
 		return NewBool(val), nil
 	case int:
@@ -187,6 +199,7 @@ func ToGoValue(v Value) interface{} {
 	case *Null:
 		return nil
 	default:
-		return nil
+		// Non-primitive values have no Go equivalent and are passed through
+		return v
 	}
 }
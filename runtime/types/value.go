@@ -1,6 +1,9 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+	"strconv"
+)
 
 // Type represents the type of a Value
 type Type int
@@ -28,8 +31,47 @@ const (
 	// TypeClass denotes a class
 	TypeClass
 
+	// TypeInterface denotes an interface
+	TypeInterface
+
+	// TypeTrait denotes a trait
+	TypeTrait
+
+	// TypeType denotes a type bound to a type parameter, see TypeValue
+	TypeType
+
 	// TypeObject denotes an object instance (e.g., an instance of a class)
 	TypeObject
+
+	// TypeArray denotes an Array collection
+	TypeArray
+
+	// TypeMap denotes a Map collection
+	TypeMap
+
+	// TypeRange denotes a range of integers, e.g. 3..5
+	TypeRange
+
+	// TypeTuple denotes an immutable list of values, such as the values returned by a function returning multiple values
+	TypeTuple
+
+	// TypeNamespace denotes the symbols of a module or namespace imported under an alias, e.g. gec in import GameEngine/Core as gec
+	TypeNamespace
+
+	// TypePromise denotes the eventual result of an asynchronous operation, such as a call to an async function
+	TypePromise
+
+	// TypeTimer denotes a handle to a callback scheduled by setTimeout or setInterval, see clearTimer
+	TypeTimer
+
+	// TypeChannel denotes a channel passing values between isolates, e.g. Channel<int>
+	TypeChannel
+
+	// TypeView denotes a lazy filter over the elements of an Array or Map, see View
+	TypeView
+
+	// TypeAny denotes a value of any type, used by built-in functions accepting mixed values
+	TypeAny
 )
 
 // String returns the string representation of a Type
@@ -57,8 +99,34 @@ func (t Type) String() string {
 		return "lambda"
 	case TypeClass:
 		return "class"
+	case TypeInterface:
+		return "interface"
+	case TypeTrait:
+		return "trait"
+	case TypeType:
+		return "type"
 	case TypeObject:
 		return "object"
+	case TypeArray:
+		return "Array"
+	case TypeMap:
+		return "Map"
+	case TypeRange:
+		return "Range"
+	case TypeTuple:
+		return "Tuple"
+	case TypeNamespace:
+		return "namespace"
+	case TypePromise:
+		return "Promise"
+	case TypeTimer:
+		return "Timer"
+	case TypeChannel:
+		return "Channel"
+	case TypeView:
+		return "View"
+	case TypeAny:
+		return "any"
 	default:
 		return "unknown"
 	}
@@ -83,6 +151,15 @@ type Value interface {
 	Clone() Value
 }
 
+// Inspect returns the representation of a value as it would be written in zen code.
+// Unlike String, strings are quoted, so ["a", "b"] can be told apart from [a, b]
+func Inspect(v Value) string {
+	if str, ok := v.(*String); ok {
+		return strconv.Quote(str.Value())
+	}
+	return v.String()
+}
+
 // TypeError represents an error during type operations
 type TypeError struct {
 	Message string
@@ -97,6 +174,20 @@ func NewTypeError(format string, args ...interface{}) error {
 	return &TypeError{Message: fmt.Sprintf(format, args...)}
 }
 
+// IndexError represents an index outside the elements of a collection, e.g. names[3] of an array of length 3
+type IndexError struct {
+	Message string
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("Index error: %s", e.Message)
+}
+
+// NewIndexError creates a new IndexError with the given message
+func NewIndexError(format string, args ...interface{}) error {
+	return &IndexError{Message: fmt.Sprintf(format, args...)}
+}
+
 // IsNumeric returns true if the given type is numeric (int, float, int64, float64)
 func IsNumeric(t Type) bool {
 	switch t {
@@ -0,0 +1,108 @@
+package interpreter
+
+import (
+	"testing"
+)
+
+func TestArrays(t *testing.T) {
+	i := InterpretTestFile(t, "arrays.zen")
+	if i == nil {
+		t.Fatal("Failed to interpret test file")
+	}
+
+	AssertValue(t, i, "fixedLength", 3)
+	AssertValue(t, i, "fixedSecond", 2)
+	AssertValue(t, i, "dynamicLength", 2)
+	AssertValue(t, i, "dynamicLast", 7)
+	AssertValue(t, i, "infLength", 2)
+
+	AssertValue(t, i, "firstName", "John")
+	AssertValue(t, i, "secondName", "Janette")
+	AssertValue(t, i, "first", "John")
+	AssertValue(t, i, "last", "Dave")
+	AssertValue(t, i, "length", 3)
+
+	AssertValue(t, i, "slicedFirst", "Janette")
+	AssertValue(t, i, "slicedLength", 2)
+	AssertValue(t, i, "reversedFirst", "Dave")
+	AssertValue(t, i, "reversedLast", "John")
+	AssertValue(t, i, "sharedLength", 4)
+
+	AssertValue(t, i, "zeroInt", 0)
+	AssertValue(t, i, "resizedLength", 3)
+	AssertValue(t, i, "zeroString", "")
+	AssertValue(t, i, "zeroAny", nil)
+	AssertValue(t, i, "shrunkLength", 1)
+
+	AssertValue(t, i, "counter", 15)
+	AssertValue(t, i, "cell", 3)
+	AssertValue(t, i, "total", 10)
+
+	AssertValue(t, i, "doubled", 42)
+	AssertValue(t, i, "maybeLength", 2)
+	AssertValue(t, i, "mixedSecond", "two")
+}
+
+func TestArrayErrors(t *testing.T) {
+	// Test appending beyond the capacity of a fixed size array
+	AssertInterpretError(t, `
+		var fixedArray: Array<int, 3> = [1, 2, 3]
+		fixedArray.append(42)
+	`)
+
+	// Test initializing with more elements than the capacity
+	AssertInterpretError(t, `var fixedArray: Array<int, 2> = [1, 2, 3]`)
+
+	// Test element type checking
+	AssertInterpretError(t, `var numbers: Array<int> = [1, "two"]`)
+	AssertInterpretError(t, `
+		var numbers: Array<int> = [1, 2]
+		numbers[0] = "one"
+	`)
+	AssertInterpretError(t, `
+		var numbers: Array<int> = []
+		numbers.append(true)
+	`)
+
+	// Test index out of bounds
+	AssertInterpretError(t, `
+		var names = ["John"]
+		var name = names[1]
+	`)
+	AssertInterpretError(t, `
+		var names = ["John"]
+		names[-1] = "Jane"
+	`)
+
+	// Test non-integer index
+	AssertInterpretError(t, `
+		var names = ["John"]
+		var name = names["0"]
+	`)
+
+	// Test element types given as function, nullable and union types
+	AssertInterpretError(t, `var maybes: Array<int> = [1, null]`)
+	AssertInterpretError(t, `var mixed: Array<int | string> = [1, true]`)
+	AssertInterpretError(t, `var callbacks: Array<func(int): int> = [1]`)
+
+	// Test resizing beyond the capacity
+	AssertInterpretError(t, `
+		var fixedArray: Array<int, 3> = [1]
+		fixedArray.resize(4)
+	`)
+
+	// Test unknown member
+	AssertInterpretError(t, `
+		var names = ["John"]
+		var size = names.size
+	`)
+
+	// Test passing an array with a different element type
+	AssertInterpretError(t, `
+		func sum(values: Array<int>): int {
+			return 0
+		}
+		var names: Array<string> = ["John"]
+		sum(names)
+	`)
+}
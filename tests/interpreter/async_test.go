@@ -0,0 +1,120 @@
+package interpreter
+
+import (
+	"strings"
+	"testing"
+	"zen/runtime/async"
+)
+
+func TestAsync(t *testing.T) {
+	i := InterpretTestFile(t, "async.zen")
+	if i == nil {
+		t.Fatal("Failed to interpret test file")
+	}
+
+	AssertValue(t, i, "doubled", 42)
+	promise, err := i.GetValue("promise")
+	if err != nil {
+		t.Fatalf("Failed to get promise: %v", err)
+	}
+	if p, ok := promise.(*async.Promise); !ok || p.State() != async.Fulfilled || p.String() != "Promise { 42 }" {
+		t.Errorf("Expected a promise fulfilled with 42, got %v", promise)
+	}
+	AssertValue(t, i, "interleaved", "a1,b1,main,a2,b2,a done,b done,")
+	AssertValue(t, i, "total", 6)
+	AssertValue(t, i, "caught", "boom")
+	AssertValue(t, i, "plain", 5)
+	AssertValue(t, i, "typedValue", 8)
+	AssertValue(t, i, "count", 2)
+}
+
+func TestAsyncEventLoop(t *testing.T) {
+	// Test tasks that aren't awaited still complete before the program exits
+	i, err := InterpretString(`var log = ""
+async func later() {
+    await null
+    log += "later"
+}
+later()
+log += "main,"`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	AssertValue(t, i, "log", "main,later")
+
+	// Test promises resolve in the order they settle
+	i, err = InterpretString(`var log = ""
+async func step(name: string, delay: int) {
+    for n in 0..delay {
+        await null
+    }
+    log += name
+}
+var slow = step("slow,", 3)
+var fast = step("fast,", 1)
+await slow
+await fast`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	AssertValue(t, i, "log", "fast,slow,")
+}
+
+func TestAsyncErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		expected string
+	}{
+		{
+			name: "await in a function that isn't async",
+			code: `async func f() {}
+func g() { await f() }
+g()`,
+			expected: "'await' is only allowed in async functions and at the top level",
+		},
+		{
+			name: "rejected promise that is never awaited",
+			code: `async func f() { throw new Exception("unhandled") }
+f()`,
+			expected: "unhandled",
+		},
+		{
+			name: "error in an async function",
+			code: `async func f() { return missing }
+await f()`,
+			expected: "Undefined variable 'missing'",
+		},
+		{
+			name: "return type of an async function",
+			code: `async func f() : int { return "five" }
+await f()`,
+			expected: "expected int",
+		},
+		{
+			name: "promise that never settles",
+			code: `var p: Promise? = null
+async func f() {
+    await null
+    await p
+}
+p = f()
+await p`,
+			expected: "Awaited promise never settles",
+		},
+		{
+			name:     "promise type",
+			code:     `var p: Promise<int> = 5`,
+			expected: "expected Promise<int>, got int",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := InterpretString(test.code)
+			if err == nil || !strings.Contains(err.Error(), test.expected) {
+				t.Errorf("Expected error containing %q, got %v", test.expected, err)
+			}
+		})
+	}
+}
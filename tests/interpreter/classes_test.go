@@ -0,0 +1,100 @@
+package interpreter
+
+import (
+	"testing"
+)
+
+func TestClasses(t *testing.T) {
+	i := InterpretTestFile(t, "classes.zen")
+	if i == nil {
+		t.Fatal("Failed to interpret test file")
+	}
+
+	AssertValue(t, i, "px", 1)
+	AssertValue(t, i, "py", 5)
+	AssertValue(t, i, "pLabelMissing", true)
+	AssertValue(t, i, "qLabel", "q")
+
+	AssertValue(t, i, "aliceAge", 30)
+	AssertValue(t, i, "bobAge", 0)
+	AssertValue(t, i, "unknownName", "Unknown")
+
+	AssertValue(t, i, "greeting", "hi Alice")
+	AssertValue(t, i, "aliceOlder", 31)
+	AssertValue(t, i, "olderThanBob", true)
+	AssertValue(t, i, "bobGreeting", "hi Bob")
+	AssertValue(t, i, "bobName", "Robert")
+	AssertValue(t, i, "typedName", "Unknown")
+	AssertValue(t, i, "second", 2)
+}
+
+func TestClassErrors(t *testing.T) {
+	// Test too many arguments for the inferred constructor
+	AssertInterpretError(t, `
+class Point { x: int }
+var p = Point(1, 2)`)
+
+	// Test field types
+	AssertInterpretError(t, `
+class Point { x: int }
+var p = Point("one")`)
+	AssertInterpretError(t, `
+class Point { x: int }
+var p = Point(1)
+p.x = "two"`)
+
+	// Test undeclared fields and methods
+	AssertInterpretError(t, `
+class Point { x: int }
+var p = Point(1)
+p.y = 2`)
+	AssertInterpretError(t, `
+class Point { x: int }
+var p = Point(1)
+p.move()`)
+
+	// Test constant fields
+	AssertInterpretError(t, `
+class Point { const x: int = 1 }
+var p = Point()
+p.x = 2`)
+
+	// Test fields that are never initialized
+	AssertInterpretError(t, `
+class Node { next: Node }
+var n = Node()`)
+
+	// Test multiple primary constructors
+	AssertInterpretError(t, `
+class Person {
+    name: string
+    func init(name: string) { this.name = name }
+    func init() { this.name = "x" }
+}`)
+
+	// Test no matching constructor
+	AssertInterpretError(t, `
+class Person {
+    name: string
+    func init(name: string) { this.name = name }
+    func init() { this.init("x") }
+}
+var p = Person(1, 2)`)
+
+	// Test duplicate members
+	AssertInterpretError(t, `
+class Person {
+    name: string
+    name() { }
+}`)
+
+	// Test 'new' with something that isn't a class
+	AssertInterpretError(t, `
+func make() { }
+var p = new make()`)
+
+	// Test variables typed with a class
+	AssertInterpretError(t, `
+class Person { }
+var p: Person = 5`)
+}
@@ -0,0 +1,53 @@
+package interpreter
+
+import (
+	"testing"
+)
+
+func TestClosures(t *testing.T) {
+	i := InterpretTestFile(t, "closures.zen")
+	if i == nil {
+		t.Fatal("Failed to interpret test file")
+	}
+
+	AssertValue(t, i, "doubled", 10)
+
+	// Test counter closure
+	AssertValue(t, i, "first", 11)
+	AssertValue(t, i, "second", 12)
+	AssertValue(t, i, "otherFirst", 1)
+	AssertValue(t, i, "third", 13)
+	AssertUndefined(t, i, "count")
+
+	AssertValue(t, i, "complexResult", 16)
+	AssertValue(t, i, "opResult", 7)
+	AssertValue(t, i, "applied", 101)
+	AssertValue(t, i, "appliedNamed", 42)
+	AssertValue(t, i, "readResult", "after")
+	AssertValue(t, i, "nestedResult", "nested")
+}
+
+func TestClosureErrors(t *testing.T) {
+	// Test lambda with wrong number of parameters for a function type
+	AssertInterpretError(t, `
+		func apply(f: func(int): int): int {
+			return f(1)
+		}
+		apply({ a, b -> a + b })
+	`)
+
+	// Test returning a non-function for a function type
+	AssertInterpretError(t, `
+		func make(): func(): int {
+			return 1
+		}
+		make()
+	`)
+
+	// Test lambda parameters are not visible outside
+	AssertInterpretError(t, `
+		var f = { x -> x }
+		f(1)
+		var y = x
+	`)
+}
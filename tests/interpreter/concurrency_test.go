@@ -0,0 +1,159 @@
+package interpreter
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"zen/runtime/async"
+)
+
+func TestConcurrency(t *testing.T) {
+	i := InterpretTestFileWithClock(t, "concurrency.zen", async.NewVirtualClock())
+	if i == nil {
+		t.Fatal("Failed to interpret test file")
+	}
+
+	AssertValue(t, i, "total", 4950)
+	AssertValue(t, i, "square", 49)
+	AssertValue(t, i, "doubled", 90)
+	AssertValue(t, i, "buffered", 2)
+	AssertValue(t, i, "bufferCapacity", 2)
+	AssertValue(t, i, "first", "a")
+	AssertValue(t, i, "second", "b")
+	AssertValue(t, i, "drained", nil)
+	AssertValue(t, i, "closed", true)
+	AssertValue(t, i, "log", "nothing ready,received,sent,ping")
+}
+
+func TestConcurrencyErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		expected string
+	}{
+		{
+			name: "passing a mutable value to a spawned function",
+			code: `func count(xs: Array<int>): int { return xs.length }
+await spawn count([1, 2, 3])`,
+			expected: "Cannot pass Array to spawned function 'count', only immutable values and channels can be shared between isolates",
+		},
+		{
+			name: "returning a mutable value from a spawned function",
+			code: `func make(): Array<int> { return [1, 2] }
+await spawn make()`,
+			expected: "Cannot return Array from spawned function 'make'",
+		},
+		{
+			name: "sending a mutable value",
+			code: `var channel = new Channel<any>(1)
+channel.send([1, 2])`,
+			expected: "only immutable values and channels can be shared between isolates",
+		},
+		{
+			name: "sending a value of the wrong type",
+			code: `var channel = new Channel<int>(1)
+channel.send("one")`,
+			expected: "Cannot send on Channel<int>",
+		},
+		{
+			name:     "channel without element type",
+			code:     `var channel = new Channel()`,
+			expected: "Channel expects the type of its values as type argument",
+		},
+		{
+			name:     "negative capacity",
+			code:     `var channel = new Channel<int>(-1)`,
+			expected: "Invalid channel capacity -1, expected a non-negative integer",
+		},
+		{
+			name: "sending on a closed channel",
+			code: `var channel = new Channel<int>(1)
+channel.close()
+channel.send(1)`,
+			expected: "Cannot send on a closed channel",
+		},
+		{
+			name: "closing a channel twice",
+			code: `var channel = new Channel<int>()
+channel.close()
+channel.close()`,
+			expected: "Cannot close a channel that is already closed",
+		},
+		{
+			name: "receiving without any sender",
+			code: `var channel = new Channel<int>()
+channel.receive()`,
+			expected: "Deadlock",
+		},
+		{
+			name: "isolates waiting for each other",
+			code: `func wait(channel: Channel<int>) { channel.receive() }
+var channel = new Channel<int>()
+await spawn wait(channel)`,
+			expected: "Deadlock",
+		},
+		{
+			name: "spawning a lambda",
+			code: `const double = { x -> x * 2 }
+await spawn double(2)`,
+			expected: "only functions declared at the top level of a file can be spawned",
+		},
+		{
+			name: "error in a spawned function",
+			code: `func fail() { throw new Exception("failed in isolate") }
+await spawn fail()`,
+			expected: "failed in isolate",
+		},
+		{
+			name: "select on something that isn't a channel",
+			code: `select {
+    "channel".receive() { }
+}`,
+			expected: "Cannot select on string, expected a Channel",
+		},
+		{
+			name: "mutable variables aren't shared",
+			code: `var count = 1
+func read(): int { return count }
+await spawn read()`,
+			expected: "Undefined variable 'count'",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := InterpretStringWithClock(test.code, async.NewVirtualClock())
+			if err == nil || !strings.Contains(err.Error(), test.expected) {
+				t.Errorf("Expected error containing %q, got %v", test.expected, err)
+			}
+		})
+	}
+}
+
+func TestSpawnImportedModule(t *testing.T) {
+	// Test isolates only execute the declarations of the modules they import, whose top-level prints run once
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Failed to capture stdout: %v", err)
+	}
+	stdout := os.Stdout
+	os.Stdout = writer
+	i, err := InterpretStringWithClock(`from GameEngine/Banner import shout
+func run(): string { return shout() }
+const first = await spawn run()
+const second = await spawn run()`, async.NewVirtualClock())
+	os.Stdout = stdout
+	writer.Close()
+	output, _ := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if count := strings.Count(string(output), "loading banner"); count != 1 {
+		t.Errorf("Expected the module to print once, printed %d times:\n%s", count, output)
+	}
+	// The constants of the module are defined in the isolates
+	AssertValue(t, i, "first", "zen!")
+	AssertValue(t, i, "second", "zen!")
+}
@@ -0,0 +1,48 @@
+package interpreter
+
+import (
+	"strings"
+	"testing"
+	"zen/runtime/errors"
+)
+
+func TestDefer(t *testing.T) {
+	i := InterpretTestFile(t, "defer.zen")
+	if i == nil {
+		t.Fatal("Failed to interpret test file")
+	}
+
+	AssertValue(t, i, "result", 1)
+	AssertValue(t, i, "workLog", "body,b,a,second,first,")
+	AssertValue(t, i, "caught", "boom")
+	AssertValue(t, i, "failingLog", "cleanup,")
+	AssertValue(t, i, "counterLog", "opened,")
+	AssertValue(t, i, "closerLog", "closed,")
+}
+
+func TestTopLevelDefer(t *testing.T) {
+	// Test top-level deferred expressions run when the program exits
+	i, err := InterpretString(`var log = ""
+defer { -> log += "exit" }()
+log += "body,"`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	AssertValue(t, i, "log", "body,exit")
+
+	// Test they run when the program exits with an uncaught exception
+	i, err = InterpretString(`var log = ""
+defer { -> log += "exit" }()
+throw new Exception("boom")`)
+	if _, ok := err.(*errors.Exception); !ok {
+		t.Fatalf("Expected an uncaught exception, got %v", err)
+	}
+	AssertValue(t, i, "log", "exit")
+
+	// Test an error in a deferred expression is reported
+	_, err = InterpretString(`func f() { defer missing() }
+f()`)
+	if err == nil || !strings.Contains(err.Error(), "Undefined variable 'missing'") {
+		t.Errorf("Expected undefined variable error, got %v", err)
+	}
+}
@@ -0,0 +1,67 @@
+package interpreter
+
+import (
+	"strings"
+	"testing"
+	"zen/runtime/errors"
+)
+
+func TestExceptions(t *testing.T) {
+	i := InterpretTestFile(t, "exceptions.zen")
+	if i == nil {
+		t.Fatal("Failed to interpret test file")
+	}
+
+	AssertValue(t, i, "caught", "server error")
+	AssertValue(t, i, "isException", true)
+
+	AssertValue(t, i, "steps", "catch,finally,finally,return,break,break,inner")
+	AssertValue(t, i, "fetched", "user")
+	AssertValue(t, i, "earlyResult", 1)
+	AssertValue(t, i, "outerCaught", "no such user")
+
+	AssertValue(t, i, "divisionMessage", "division by zero")
+	AssertValue(t, i, "undefinedIsRuntime", true)
+	AssertValue(t, i, "typeCaught", true)
+	AssertValue(t, i, "indexMessage", "array index 1 out of bounds for length 1")
+	AssertValue(t, i, "indexIsType", false)
+
+	AssertValue(t, i, "rethrown", "wrapped no such user")
+	AssertValue(t, i, "status", 404)
+}
+
+func TestUncaughtExceptions(t *testing.T) {
+	// Test uncaught exceptions carry the function calls they were thrown from
+	_, err := InterpretString(`func outer() { inner() }
+func inner() { throw new Exception("failed") }
+outer()`)
+	exception, ok := err.(*errors.Exception)
+	if !ok {
+		t.Fatalf("Expected an uncaught exception, got %v", err)
+	}
+	if exception.Message() != "failed" {
+		t.Errorf("Expected message 'failed', got %s", exception.Message())
+	}
+	trace := exception.StackTrace()
+	for _, expected := range []string{"Uncaught Exception: failed", "at inner (Line 2", "at outer (Line 1", "at <main> (Line 3"} {
+		if !strings.Contains(trace, expected) {
+			t.Errorf("Expected stack trace to contain %q, got:\n%s", expected, trace)
+		}
+	}
+
+	// Test exceptions not caught by any catch clause propagate after the finally block
+	_, err = InterpretString(`try { throw new Exception("x") } catch e: TypeException { }`)
+	if _, ok := err.(*errors.Exception); !ok {
+		t.Errorf("Expected an uncaught exception, got %v", err)
+	}
+
+	// Test only exceptions can be thrown and caught
+	_, err = InterpretString(`throw "oops"`)
+	if err == nil || !strings.Contains(err.Error(), "expected an Exception") {
+		t.Errorf("Expected error throwing a string, got %v", err)
+	}
+	_, err = InterpretString(`try { throw new Exception("x") } catch e: int { }`)
+	if err == nil || !strings.Contains(err.Error(), "expected a class extending Exception") {
+		t.Errorf("Expected error catching an int, got %v", err)
+	}
+}
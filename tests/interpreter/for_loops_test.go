@@ -0,0 +1,98 @@
+package interpreter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestForLoops(t *testing.T) {
+	i := InterpretTestFile(t, "for_loops.zen")
+	if i == nil {
+		t.Fatal("Failed to interpret test file")
+	}
+
+	AssertValue(t, i, "sum", 45)
+	AssertValue(t, i, "evens", 5)
+	AssertUndefined(t, i, "i")
+	AssertUndefined(t, i, "x")
+
+	AssertValue(t, i, "total", 13)
+	AssertValue(t, i, "weighted", 19)
+	AssertUndefined(t, i, "number")
+
+	AssertValue(t, i, "places", "Tokyo,Florida,")
+	AssertValue(t, i, "degreesTotal", 46)
+	AssertValue(t, i, "counted", 123)
+
+	AssertValue(t, i, "odds", 3)
+	AssertValue(t, i, "whileCount", 5)
+	AssertValue(t, i, "skipped", 2)
+	AssertValue(t, i, "pairs", 6)
+	AssertValue(t, i, "foundIndex", 1)
+
+	AssertValue(t, i, "joined", "Names: john, jane, dave")
+	AssertValue(t, i, "indexSum", 6)
+	AssertValue(t, i, "loopLength", 4)
+	AssertValue(t, i, "innerLengths", 18)
+	AssertValue(t, i, "outerIndices", 1)
+	AssertValue(t, i, "mapLast", "b")
+	AssertUndefined(t, i, "loop")
+}
+
+func TestForLoopErrors(t *testing.T) {
+	// Test iterating over a non-iterable value
+	AssertInterpretError(t, `
+		for c in "abc" {
+			var x = c
+		}
+	`)
+
+	// Test non-boolean condition
+	AssertInterpretError(t, `
+		for i = 0; 42; i++ {
+			var x = i
+		}
+	`)
+
+	// Test the loop object is read-only
+	AssertInterpretError(t, `
+		for n in 3 {
+			loop = 1
+		}
+	`)
+
+	// Test the loop object only exists in for-in loops
+	AssertInterpretError(t, `
+		for i = 0; i < 3; i++ {
+			var first = loop.first
+		}
+	`)
+
+	// Test break and continue inside a function called from a loop
+	AssertInterpretError(t, `
+		func stop() {
+			break
+		}
+		for n in 3 {
+			stop()
+		}
+	`)
+}
+
+func TestBreakOutsideLoop(t *testing.T) {
+	_, err := InterpretString("var x = 1\nbreak")
+	if err == nil {
+		t.Fatal("Expected error for break outside of a loop")
+	}
+	if !strings.Contains(err.Error(), "'break' outside of a loop") || !strings.Contains(err.Error(), "Line 2") {
+		t.Errorf("Expected break diagnostic at line 2, got: %v", err)
+	}
+
+	_, err = InterpretString("continue")
+	if err == nil {
+		t.Fatal("Expected error for continue outside of a loop")
+	}
+	if !strings.Contains(err.Error(), "'continue' outside of a loop") {
+		t.Errorf("Expected continue diagnostic, got: %v", err)
+	}
+}
@@ -0,0 +1,140 @@
+package interpreter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFunctions(t *testing.T) {
+	i := InterpretTestFile(t, "functions.zen")
+	if i == nil {
+		t.Fatal("Failed to interpret test file")
+	}
+
+	AssertValue(t, i, "sum", 5)
+	AssertValue(t, i, "calls", 2)
+	AssertValue(t, i, "fact", 120)
+	AssertValue(t, i, "above", 4)
+
+	// Test nullable and default parameters
+	AssertValue(t, i, "greeting1", "Hello Alice")
+	AssertValue(t, i, "greeting2", "Hi Bob!")
+
+	// Test that parameters don't leak out of the function
+	AssertValue(t, i, "shadowed", "global")
+	AssertValue(t, i, "shadowResult", "param")
+	AssertUndefined(t, i, "name")
+
+	AssertValue(t, i, "halved", 2.5)
+	AssertValue(t, i, "laterResult", 7)
+}
+
+func TestFunctionErrors(t *testing.T) {
+	// Test wrong argument type
+	AssertInterpretError(t, `
+		func add(a:int, b:int):int {
+			return a + b
+		}
+		add(1, "two")
+	`)
+
+	// Test missing argument
+	AssertInterpretError(t, `
+		func add(a:int, b:int):int {
+			return a + b
+		}
+		add(1)
+	`)
+
+	// Test too many arguments
+	AssertInterpretError(t, `
+		func one():int {
+			return 1
+		}
+		one(1)
+	`)
+
+	// Test wrong return type
+	AssertInterpretError(t, `
+		func name():string {
+			return 42
+		}
+		name()
+	`)
+
+	// Test missing return value
+	AssertInterpretError(t, `
+		func name():string {
+			var x = 1
+		}
+		name()
+	`)
+
+	// Test returning a value from a void function
+	AssertInterpretError(t, `
+		func nothing() {
+			return 1
+		}
+		nothing()
+	`)
+
+	// Test return outside of a function
+	AssertInterpretError(t, `
+		return 1
+	`)
+
+	// Test null for non-nullable parameter
+	AssertInterpretError(t, `
+		func say(something:string) {
+			print(something)
+		}
+		say(null)
+	`)
+
+	// Test caller's local variables are not visible in the callee
+	AssertInterpretError(t, `
+		func peek():int {
+			return local
+		}
+		if true {
+			var local = 1
+			peek()
+		}
+	`)
+
+	// Test infinite recursion
+	AssertInterpretError(t, `
+		func forever():int {
+			return forever()
+		}
+		forever()
+	`)
+
+	// Test calling a non-function
+	AssertInterpretError(t, `
+		var x = 1
+		x()
+	`)
+}
+
+func TestBuiltinNullArguments(t *testing.T) {
+	// Test an explicit null is passed to parameters accepting it, rather than treated as a missing argument
+	if _, err := InterpretString(`print(null)
+print([].first)`); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		code     string
+		expected string
+	}{
+		{`include(null)`, "Invalid argument 'path' in call to 'include': expected string, got null"},
+		{`include()`, "Missing argument 'path' in call to 'include'"},
+	}
+	for _, test := range tests {
+		_, err := InterpretString(test.code)
+		if err == nil || !strings.Contains(err.Error(), test.expected) {
+			t.Errorf("Expected error containing %q for %s, got %v", test.expected, test.code, err)
+		}
+	}
+}
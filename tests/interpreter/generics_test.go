@@ -0,0 +1,109 @@
+package interpreter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerics(t *testing.T) {
+	i := InterpretTestFile(t, "generics.zen")
+	if i == nil {
+		t.Fatal("Failed to interpret test file")
+	}
+
+	AssertValue(t, i, "boxed", 6)
+	AssertValue(t, i, "isIntBox", true)
+	AssertValue(t, i, "isStringBox", false)
+	AssertValue(t, i, "mapped", true)
+	AssertValue(t, i, "inferredIsString", true)
+	AssertValue(t, i, "emptyValue", "")
+
+	AssertValue(t, i, "swappedFirst", 1)
+	AssertValue(t, i, "swappedIsPair", true)
+
+	AssertValue(t, i, "counted", 2)
+	AssertValue(t, i, "counterIsBox", true)
+	AssertValue(t, i, "crateCount", 2)
+
+	AssertValue(t, i, "gridSize", 3)
+	AssertValue(t, i, "gridLength", 1)
+	AssertValue(t, i, "fixedLength", 2)
+
+	AssertValue(t, i, "firstInt", 3)
+	AssertValue(t, i, "firstString", "a")
+	AssertValue(t, i, "unwrapped", "q")
+}
+
+func TestGenericErrors(t *testing.T) {
+	// Test type arguments checked at instantiation, naming the violated parameter
+	_, err := InterpretString(`class Box<T> { value: T }
+var b = new Box<int>("x")`)
+	if err == nil || !strings.Contains(err.Error(), "type parameter T of 'Box'") {
+		t.Errorf("Expected error naming type parameter T, got %v", err)
+	}
+
+	// Test arguments of methods and generic functions
+	_, err = InterpretString(`class Box<T> {
+    value: T
+    set(value: T) { this.value = value }
+}
+var b = Box<int>(1)
+b.set("two")`)
+	if err == nil || !strings.Contains(err.Error(), "type parameter T of 'Box'") {
+		t.Errorf("Expected error naming type parameter T, got %v", err)
+	}
+	_, err = InterpretString(`func id<T>(x: T): T { return x }
+var s = id<int>("s")`)
+	if err == nil || !strings.Contains(err.Error(), "type parameter T of 'id'") {
+		t.Errorf("Expected error naming type parameter T, got %v", err)
+	}
+
+	// Test value parameters only take integers
+	_, err = InterpretString(`class Grid<T, N: int> { cells: Array<T, N> }
+var g = Grid<int, string>()`)
+	if err == nil || !strings.Contains(err.Error(), "type parameter N of 'Grid'") {
+		t.Errorf("Expected error naming type parameter N, got %v", err)
+	}
+	_, err = InterpretString(`class FixedArray<T, S> extends Array<T, S> {}
+var f = FixedArray<int, string>()`)
+	if err == nil || !strings.Contains(err.Error(), "type parameter S of 'FixedArray'") {
+		t.Errorf("Expected error naming type parameter S, got %v", err)
+	}
+	AssertInterpretError(t, `
+class Grid<T, N: int> { cells: Array<T, N> }
+var g = Grid()`)
+	AssertInterpretError(t, `class Grid<N: string> {}`)
+
+	// Test the size given for a value parameter
+	AssertInterpretError(t, `
+class FixedArray<T, S> extends Array<T, S> {}
+var f = FixedArray<int, 1>()
+f.append(1)
+f.append(2)`)
+
+	// Test the number of type arguments
+	AssertInterpretError(t, `
+class Box<T> { value: T }
+var b = Box<int, int>(1)`)
+	AssertInterpretError(t, `
+class Box<T> { value: T }
+var b: Box<int, int>? = null`)
+
+	// Test type arguments of non-generic classes and functions
+	AssertInterpretError(t, `
+class Point { x: int }
+var p = Point<int>(1)`)
+	AssertInterpretError(t, `
+func f(x: int): int { return x }
+var y = f<int>(1)`)
+
+	// Test objects of generic classes only match their type arguments
+	AssertInterpretError(t, `
+class Box<T> { value: T }
+var b: Box<string> = Box<int>(1)`)
+
+	// Test extending a generic class without type arguments
+	AssertInterpretError(t, `
+class Box<T> { value: T }
+class Crate extends Box {}`)
+}
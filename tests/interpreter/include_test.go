@@ -0,0 +1,64 @@
+package interpreter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestInclude(t *testing.T) {
+	i := InterpretTestFile(t, "include.zen")
+	if i == nil {
+		t.Fatal("Failed to interpret test file")
+	}
+
+	AssertValue(t, i, "configName", "zen")
+	AssertValue(t, i, "configVolume", 10)
+	AssertValue(t, i, "configAgainName", "zen")
+	AssertValue(t, i, "included", 5)
+	AssertValue(t, i, "doubled", 8)
+	AssertValue(t, i, "nestedName", "zen")
+
+	AssertValue(t, i, "title", "Settings")
+	AssertValue(t, i, "settingsVolume", 7)
+	AssertValue(t, i, "ratio", 0.5)
+	AssertValue(t, i, "darkMode", true)
+	AssertValue(t, i, "hasTheme", false)
+	AssertValue(t, i, "secondTag", "b")
+	AssertValue(t, i, "width", 800)
+
+	AssertValue(t, i, "userCount", 2)
+	AssertValue(t, i, "secondUser", "jane")
+
+	AssertValue(t, i, "greeting", "hello world")
+
+	// Variables of an included file returning a value don't leak into the including scope
+	if _, err := i.GetValue("volume"); err == nil {
+		t.Error("Expected 'volume' of config.zen to be undefined in the including scope")
+	}
+}
+
+func TestIncludeErrors(t *testing.T) {
+	tests := []struct {
+		source   string
+		expected string
+	}{
+		{`include("include/missing.zen")`, "Cannot read"},
+		{`include("include/users.txt")`, "there is no loader for '.txt' files"},
+		{`include("include/_Greeter_methods.zen")`, "Expected"},
+		{`return 1`, "Cannot return outside of a function"},
+		{"class A {\n    include(\"include/config.zen\")\n}", "Syntax error in"},
+		{`include("include/self.zen")`, "Include cycle: "},
+		{`include("include/cycle_a.zen")`, "cycle_a.zen -> "},
+	}
+
+	for _, test := range tests {
+		_, err := InterpretString(test.source)
+		if err == nil {
+			t.Errorf("Expected error for:\n%s", test.source)
+			continue
+		}
+		if !strings.Contains(err.Error(), test.expected) {
+			t.Errorf("Expected error containing %q, got %q", test.expected, err.Error())
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package interpreter
+
+import (
+	"testing"
+)
+
+func TestInheritance(t *testing.T) {
+	i := InterpretTestFile(t, "inheritance.zen")
+	if i == nil {
+		t.Fatal("Failed to interpret test file")
+	}
+
+	AssertValue(t, i, "animalSays", "generic says ...")
+	AssertValue(t, i, "rexSays", "Rex says woof")
+	AssertValue(t, i, "pupSays", "Bit says woof!")
+
+	AssertValue(t, i, "rexLegs", 4)
+	AssertValue(t, i, "rexTricks", 3)
+	AssertValue(t, i, "pupName", "Bit")
+
+	AssertValue(t, i, "dogName", "Rex")
+	AssertValue(t, i, "sounds", "... woof woof! ")
+	AssertValue(t, i, "p3Sum", 6)
+	AssertValue(t, i, "adopted", "woof")
+
+	AssertValue(t, i, "popped", 3)
+	AssertValue(t, i, "stackLength", 2)
+	AssertValue(t, i, "stackFirst", 1)
+	AssertValue(t, i, "stackTotal", 21)
+	AssertValue(t, i, "stackSum", 21)
+	AssertValue(t, i, "registryB", 1)
+	AssertValue(t, i, "registryHasA", true)
+}
+
+func TestInheritanceErrors(t *testing.T) {
+	// Test extending something that isn't a class, Array or Map
+	AssertInterpretError(t, `class A extends Missing {}`)
+	AssertInterpretError(t, `class A extends int {}`)
+	AssertInterpretError(t, `class A extends A {}`)
+
+	// Test redeclaring an inherited field
+	AssertInterpretError(t, `
+class A { x: int = 0 }
+class B extends A { x: int = 1 }`)
+
+	// Test a method with the name of an inherited field
+	AssertInterpretError(t, `
+class A { x: int = 0 }
+class B extends A {
+    func x(): int { return 1 }
+}`)
+
+	// Test overrides with incompatible signatures
+	AssertInterpretError(t, `
+class A { func f(a: int): int { return a } }
+class B extends A { func f(a: string): int { return 1 } }`)
+	AssertInterpretError(t, `
+class A { func f(a: int): int { return a } }
+class B extends A { func f(a: int, b: int): int { return a } }`)
+	AssertInterpretError(t, `
+class A { func f(): int { return 1 } }
+class B extends A { func f(): string { return "1" } }`)
+
+	// Test super in a class that doesn't extend anything
+	AssertInterpretError(t, `
+class A { func f() { super.f() } }
+A().f()`)
+
+	// Test calling a method the superclass doesn't have through super
+	AssertInterpretError(t, `
+class A {}
+class B extends A { func f() { super.g() } }
+B().f()`)
+
+	// Test a superclass instance where a subclass is expected
+	AssertInterpretError(t, `
+class A {}
+class B extends A {}
+var b: B = A()`)
+
+	// Test elements of the wrong type in a class extending Array
+	AssertInterpretError(t, `
+class Numbers extends Array<int> {}
+var n = Numbers()
+n.append("one")`)
+}
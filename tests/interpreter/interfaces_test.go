@@ -0,0 +1,93 @@
+package interpreter
+
+import (
+	"testing"
+)
+
+func TestInterfaces(t *testing.T) {
+	i := InterpretTestFile(t, "interfaces.zen")
+	if i == nil {
+		t.Fatal("Failed to interpret test file")
+	}
+
+	AssertValue(t, i, "circleIs", false)
+	AssertValue(t, i, "circleHas", true)
+	AssertValue(t, i, "circleHasDraw", true)
+	AssertValue(t, i, "squareIs", true)
+	AssertValue(t, i, "squareIsNamed", true)
+	AssertValue(t, i, "squareHas", true)
+	AssertValue(t, i, "cubeIs", true)
+	AssertValue(t, i, "cubeIsSquare", true)
+	AssertValue(t, i, "sketchHas", false)
+	AssertValue(t, i, "sketchHasDraw", false)
+	AssertValue(t, i, "numberHas", false)
+	AssertValue(t, i, "numberIs", true)
+	AssertValue(t, i, "stringIsInt", false)
+
+	AssertValue(t, i, "drawn", "drawing square drawing square ")
+	AssertValue(t, i, "squareName", "square")
+
+	AssertValue(t, i, "kind", "structural")
+	AssertValue(t, i, "cubeKind", "drawable")
+	AssertValue(t, i, "circleKind", "looks drawable")
+	AssertValue(t, i, "mapHas", true)
+	AssertValue(t, i, "counted", 2)
+}
+
+func TestInterfaceErrors(t *testing.T) {
+	// Test a class missing a method or property of an interface it implements
+	AssertInterpretError(t, `
+interface Drawable { draw(): void }
+class Circle implements Drawable {}`)
+	AssertInterpretError(t, `
+interface Named { var name: string }
+class Circle implements Named {}`)
+
+	// Test members with incompatible types
+	AssertInterpretError(t, `
+interface Drawable { draw(): void }
+class Circle implements Drawable {
+    draw(): int { return 1 }
+}`)
+	AssertInterpretError(t, `
+interface Named { var name: string }
+class Circle implements Named { name: int = 0 }`)
+	AssertInterpretError(t, `
+interface Named { var name: string }
+class Circle implements Named { const name: string = "circle" }`)
+
+	// Test type arguments for an interface that isn't generic, and members not matching the type arguments of one that is
+	AssertInterpretError(t, `
+interface Named { var name: string }
+class Circle implements Named<string> { name: string = "circle" }`)
+	AssertInterpretError(t, `
+interface Source<T> { func next(): T }
+class Circle implements Source<int> { func next(): string { return "" } }`)
+
+	// Test implementing something that isn't an interface
+	AssertInterpretError(t, `
+class A {}
+class B implements A {}`)
+
+	// Test duplicate interface members
+	AssertInterpretError(t, `
+interface Drawable {
+    draw(): void
+    var draw: int
+}`)
+
+	// Test 'has' with something that isn't an interface, or a member the interface doesn't declare
+	AssertInterpretError(t, `
+class A {}
+var b = A() has A`)
+	AssertInterpretError(t, `
+interface Drawable { draw(): void }
+class A {}
+var b = A() has Drawable.paint`)
+
+	// Test assigning an object that only structurally matches an interface
+	AssertInterpretError(t, `
+interface Drawable { draw(): void }
+class Circle { draw() {} }
+var d: Drawable = Circle()`)
+}
@@ -0,0 +1,88 @@
+package interpreter
+
+import (
+	"testing"
+	"zen/runtime/types"
+)
+
+func TestMaps(t *testing.T) {
+	i := InterpretTestFile(t, "maps.zen")
+	if i == nil {
+		t.Fatal("Failed to interpret test file")
+	}
+
+	AssertValue(t, i, "agesSize", 0)
+	AssertValue(t, i, "size", 2)
+	AssertValue(t, i, "hasVolume", true)
+	AssertValue(t, i, "hasMissing", false)
+	AssertValue(t, i, "volume", 0.5)
+	AssertValue(t, i, "missing", nil)
+	AssertValue(t, i, "containsVolume", true)
+	AssertValue(t, i, "containsMissing", false)
+
+	AssertValue(t, i, "darkMode", false)
+	AssertValue(t, i, "newSize", 3)
+	AssertValue(t, i, "memberVolume", 0.5)
+
+	AssertValue(t, i, "johnsAge", 31)
+	AssertValue(t, i, "nine", 9)
+	AssertValue(t, i, "startedYear", 1995)
+	AssertValue(t, i, "nestedYear", 1995)
+	AssertValue(t, i, "hasDefault", true)
+
+	// Test insertion order
+	described, err := i.GetValue("described")
+	if err != nil {
+		t.Fatalf("Variable described should be defined: %v", err)
+	}
+	m, ok := described.(*types.Map)
+	if !ok {
+		t.Fatalf("Expected described to be a map, got %T", described)
+	}
+	expected := `{"volume": 0.5, "dark_mode": false, "language": "en", "default": true}`
+	if m.String() != expected {
+		t.Errorf("Expected %s, got %s", expected, m.String())
+	}
+}
+
+func TestMapErrors(t *testing.T) {
+	// Test key type checking
+	AssertInterpretError(t, `var ages: Map<string, int> = {1: 30}`)
+
+	// Test value type checking
+	AssertInterpretError(t, `var ages: Map<string, int> = {"John": "thirty"}`)
+	AssertInterpretError(t, `
+		var ages: Map<string, int> = {}
+		ages{"John"} = "thirty"
+	`)
+
+	// Test assigning a map with different types to a typed variable
+	AssertInterpretError(t, `
+		var ages: Map<string, int> = {}
+		var names: Map<string, string> = {}
+		ages = names
+	`)
+
+	// Test a map literal assigned to a typed variable is bound to its type
+	AssertInterpretError(t, `
+		var ages: Map<string, int> = {}
+		ages = {"John": 30}
+		ages{"Jane"} = "thirty"
+	`)
+
+	// Test reading a missing key
+	AssertInterpretError(t, `
+		var ages: Map<string, int> = {}
+		var age = ages{"John"}
+	`)
+	AssertInterpretError(t, `
+		var config = {"volume": 0.5}
+		var language = config.language
+	`)
+
+	// Test curly access on a non-map value
+	AssertInterpretError(t, `
+		var names = ["John"]
+		var name = names{0}
+	`)
+}
@@ -0,0 +1,56 @@
+package interpreter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestModules(t *testing.T) {
+	i := InterpretTestFile(t, "modules.zen")
+	if i == nil {
+		t.Fatal("Failed to interpret test file")
+	}
+
+	AssertValue(t, i, "greeting", "hello world")
+	AssertValue(t, i, "worldGreeting", "world")
+	AssertValue(t, i, "vectorX", 1)
+	AssertValue(t, i, "scopedGreeting", "hello world")
+	AssertValue(t, i, "aliasedY", 4)
+	AssertValue(t, i, "sameClass", true)
+	AssertValue(t, i, "special", "special")
+	AssertValue(t, i, "aliasedWorld", "world")
+
+	// Private symbols and the modules of nested namespaces aren't imported
+	AssertUndefined(t, i, "greet")
+	AssertUndefined(t, i, "Nested")
+}
+
+func TestModuleErrors(t *testing.T) {
+	tests := []struct {
+		source   string
+		expected string
+	}{
+		{"from GameEngine/Core/Utils import hello\nworld()", "Undefined variable 'world'"},
+		{"from GameEngine/Core/Utils import greet", "Cannot import 'greet', it is private to module 'GameEngine/Core/Utils'"},
+		{"from GameEngine/Core import missing", "'GameEngine/Core' has no symbol 'missing'"},
+		{"import GameEngine/Core as gec\ngec.missing()", "Namespace 'GameEngine/Core' has no member 'missing'"},
+		{"import GameEngine/Core/_notes", "files starting with an underscore are ignored"},
+		{"import GameEngine/Missing", "Cannot find module or namespace 'GameEngine/Missing'"},
+		{"import Nowhere/Thing", "Cannot find package 'Nowhere'"},
+		{"import GameEngine/Cycle/A", "Import cycle: GameEngine/Cycle/A -> GameEngine/Cycle/B -> GameEngine/Cycle/A"},
+		{"func f() {}\nimport GameEngine/Core/Vector2 as f", "Cannot redefine variable: f"},
+		// Modules have their own top-level scope, they can't see the variables of the program importing them
+		{"const mainValue = \"main\"\nfrom GameEngine/Isolation import readMainValue\nreadMainValue()", "Undefined variable 'mainValue'"},
+	}
+
+	for _, test := range tests {
+		_, err := InterpretString(test.source)
+		if err == nil {
+			t.Errorf("Expected error for:\n%s", test.source)
+			continue
+		}
+		if !strings.Contains(err.Error(), test.expected) {
+			t.Errorf("Expected error containing %q, got %q", test.expected, err.Error())
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package interpreter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestOperatorOverloading(t *testing.T) {
+	i := InterpretTestFile(t, "operator_overloading.zen")
+	if i == nil {
+		t.Fatal("Failed to interpret test file")
+	}
+
+	AssertValue(t, i, "sumX", float32(4))
+	AssertValue(t, i, "sumY", float32(6))
+	AssertValue(t, i, "difference", float32(3))
+	AssertValue(t, i, "scaled", float32(6))
+	AssertValue(t, i, "negated", float32(-1))
+	AssertValue(t, i, "same", true)
+	AssertValue(t, i, "different", false)
+	AssertValue(t, i, "isNull", false)
+	AssertValue(t, i, "matched", "equal")
+
+	AssertValue(t, i, "totalCents", 500)
+	AssertValue(t, i, "cheaper", true)
+	AssertValue(t, i, "pricier", false)
+
+	AssertValue(t, i, "a1", 5)
+	AssertValue(t, i, "b2", 7)
+	AssertValue(t, i, "empty", 0)
+	AssertValue(t, i, "fromReadable", 5)
+	AssertValue(t, i, "isReadable", true)
+	AssertValue(t, i, "nine", 9)
+}
+
+func TestOperatorOverloadingErrors(t *testing.T) {
+	// Test an operator the class doesn't implement
+	AssertInterpretError(t, `
+class A { func _add(other: A): A { return this } }
+var x = A() - A()`)
+
+	// Test the error names the classes of both operands
+	_, err := InterpretString(`
+class V {}
+class W {}
+var x = V() + W()`)
+	if err == nil || !strings.Contains(err.Error(), "invalid operation: V + W, V has no _add method") {
+		t.Errorf("Expected an error naming both classes, got %v", err)
+	}
+	_, err = InterpretString(`
+class V {}
+var x = 1 + V()`)
+	if err == nil || !strings.Contains(err.Error(), "invalid operation: int64 + V") {
+		t.Errorf("Expected an error naming the class of the right operand, got %v", err)
+	}
+
+	// Test a comparison method not returning bool
+	AssertInterpretError(t, `
+class A { func _lessThan(other: A): int { return 1 } }
+var x = A() < A()`)
+
+	// Test an operand the method doesn't accept
+	AssertInterpretError(t, `
+class A { func _add(other: A): A { return this } }
+var x = A() + 1`)
+
+	// Test assigning an element of a class without _bracketSet
+	AssertInterpretError(t, `
+class A { func _bracketGet(key: int): int { return key } }
+var a = A()
+a[0] = 1`)
+
+	// Test a class implementing the built-in bracket interfaces with other types than its methods
+	_, err = InterpretString(`
+class A implements BracketGet<int, int> { func _bracketGet(key: string): int { return 0 } }`)
+	if err == nil || !strings.Contains(err.Error(), "does not implement interface 'BracketGet<int, int>'") {
+		t.Errorf("Expected an error naming the instantiated interface, got %v", err)
+	}
+	AssertInterpretError(t, `
+class A implements BracketSet<int, int> { func _bracketGet(key: int): int { return key } }`)
+	AssertInterpretError(t, `
+class A implements BracketGet<int> { func _bracketGet(key: int): int { return key } }`)
+
+	// Test an object implementing the bracket interfaces for some types isn't one of them for others
+	AssertInterpretError(t, `
+class A implements BracketGet<int, int> { func _bracketGet(key: int): int { return key } }
+var a: BracketGet<string, int> = A()`)
+
+	// Test indexing an object that doesn't implement the bracket protocol
+	AssertInterpretError(t, `
+class A {}
+var x = A()[0]`)
+}
@@ -0,0 +1,43 @@
+package interpreter
+
+import (
+	"testing"
+)
+
+func TestRanges(t *testing.T) {
+	i := InterpretTestFile(t, "ranges.zen")
+	if i == nil {
+		t.Fatal("Failed to interpret test file")
+	}
+
+	AssertValue(t, i, "sum", 7)
+	AssertValue(t, i, "squares", 14)
+	AssertValue(t, i, "lastIndex", 4)
+	AssertValue(t, i, "countdown", 321)
+
+	AssertValue(t, i, "length", 9)
+	AssertValue(t, i, "start", 1)
+	AssertValue(t, i, "end", 10)
+	AssertValue(t, i, "containsFive", true)
+	AssertValue(t, i, "containsEnd", false)
+
+	AssertValue(t, i, "evenLength", 5)
+	AssertValue(t, i, "evenSum", 20)
+	AssertValue(t, i, "containsThree", false)
+	AssertValue(t, i, "containsFour", true)
+	AssertValue(t, i, "downSum", 22) // 10 + 7 + 4 + 1
+
+	AssertValue(t, i, "hugeLength", 1000000000000)
+	AssertValue(t, i, "hugeFirst", 0)
+	AssertValue(t, i, "rangeTotal", 6)
+}
+
+func TestRangeErrors(t *testing.T) {
+	// Test non-integer bounds
+	AssertInterpretError(t, `var r = 1.5..3`)
+	AssertInterpretError(t, `var r = 0.."ten"`)
+
+	// Test steps that never reach the end
+	AssertInterpretError(t, `var r = (0..10).step(0)`)
+	AssertInterpretError(t, `var r = (0..10).step(-1)`)
+}
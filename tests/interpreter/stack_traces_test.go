@@ -0,0 +1,173 @@
+package interpreter
+
+import (
+	"fmt"
+	"testing"
+	"zen/interpreter"
+	"zen/runtime/errors"
+)
+
+// AssertStackTrace checks the function names and lines of a stack trace, innermost first
+func AssertStackTrace(t *testing.T, stack []*errors.StackFrame, expected ...interface{}) {
+	t.Helper()
+	if len(stack) != len(expected)/2 {
+		t.Fatalf("Expected %d stack frames, got %d", len(expected)/2, len(stack))
+	}
+	for idx, frame := range stack {
+		function, line := expected[idx*2].(string), expected[idx*2+1].(int)
+		if frame.Function != function {
+			t.Errorf("Expected frame %d to be %s, got %s", idx, function, frame.Function)
+		}
+		if frame.Location == nil || frame.Location.Line != line {
+			t.Errorf("Expected frame %d at line %d, got %v", idx, line, frame.Location)
+		}
+	}
+}
+
+func TestRuntimeErrorStackTrace(t *testing.T) {
+	// Test runtime errors carry the function calls they were raised in
+	_, err := InterpretString(`func outer() {
+    inner()
+}
+func inner() {
+    var y = 1 / 0
+}
+outer()`)
+	runtimeErr, ok := err.(*interpreter.RuntimeError)
+	if !ok {
+		t.Fatalf("Expected a runtime error, got %v", err)
+	}
+	AssertStackTrace(t, runtimeErr.Stack, "inner", 5, "outer", 2, "<main>", 7)
+
+	// Test errors raised at the top level
+	_, err = InterpretString(`var x = 1
+var y = nope`)
+	runtimeErr, ok = err.(*interpreter.RuntimeError)
+	if !ok {
+		t.Fatalf("Expected a runtime error, got %v", err)
+	}
+	AssertStackTrace(t, runtimeErr.Stack, "<main>", 2)
+
+	// Test errors raised in methods
+	_, err = InterpretString(`class Greeter {
+    greet() {
+        var y = missing
+    }
+}
+var g = new Greeter()
+g.greet()`)
+	runtimeErr, ok = err.(*interpreter.RuntimeError)
+	if !ok {
+		t.Fatalf("Expected a runtime error, got %v", err)
+	}
+	AssertStackTrace(t, runtimeErr.Stack, "Greeter.greet", 3, "<main>", 7)
+
+	// Test errors raised in built-in functions
+	_, err = InterpretString(`func load() {
+    include("missing.zen")
+}
+load()`)
+	runtimeErr, ok = err.(*interpreter.RuntimeError)
+	if !ok {
+		t.Fatalf("Expected a runtime error, got %v", err)
+	}
+	AssertStackTrace(t, runtimeErr.Stack, "include", 2, "load", 2, "<main>", 4)
+}
+
+func TestCallErrorStackTrace(t *testing.T) {
+	// Test invalid arguments and return values are raised at the call site, outside of the called function
+	tests := []struct {
+		name string
+		code string
+	}{
+		{
+			name: "invalid argument",
+			code: `func double(x: int): int {
+    return x * 2
+}
+func run() {
+    double("two")
+}
+run()`,
+		},
+		{
+			name: "invalid return value",
+			code: `func double(x: int): int {
+    return "two"
+}
+func run() {
+    double(2)
+}
+run()`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := InterpretString(test.code)
+			runtimeErr, ok := err.(*interpreter.RuntimeError)
+			if !ok {
+				t.Fatalf("Expected a runtime error, got %v", err)
+			}
+			AssertStackTrace(t, runtimeErr.Stack, "run", 5, "<main>", 7)
+		})
+	}
+}
+
+func TestExceptionStackTrace(t *testing.T) {
+	// Test exceptions thrown from a catch clause carry the function calls they were thrown from
+	_, err := InterpretString(`func fail() {
+    var y = 1 / 0
+}
+func run() {
+    try {
+        fail()
+    } catch e: DivisionByZeroException {
+        throw new Exception("failed: " + e.message)
+    }
+}
+run()`)
+	exception, ok := err.(*errors.Exception)
+	if !ok {
+		t.Fatalf("Expected an uncaught exception, got %v", err)
+	}
+	AssertStackTrace(t, exception.Stack, "run", 8, "<main>", 11)
+}
+
+func TestStackOverflowStackTrace(t *testing.T) {
+	// Test exceeding the maximum call depth prints the recursive calls once, followed by how many more there are
+	_, err := InterpretString(`func countdown(n: int) {
+    countdown(n - 1)
+}
+countdown(10)`)
+	runtimeErr, ok := err.(*interpreter.RuntimeError)
+	if !ok {
+		t.Fatalf("Expected a runtime error, got %v", err)
+	}
+	if len(runtimeErr.Stack) != interpreter.MaxCallDepth+1 {
+		t.Fatalf("Expected %d stack frames, got %d", interpreter.MaxCallDepth+1, len(runtimeErr.Stack))
+	}
+	trace := errors.FormatStack(runtimeErr.Stack, false)
+	expected := fmt.Sprintf("  at countdown (Line 2, Column 19)\n  ... %d more calls to countdown\n  at <main> (Line 4, Column 12)\n", interpreter.MaxCallDepth-1)
+	if trace != expected {
+		t.Errorf("Expected stack trace:\n%s\ngot:\n%s", expected, trace)
+	}
+
+	// Test cycles of calls between functions are collapsed as well
+	_, err = InterpretString(`func ping(n: int) {
+    pong(n)
+}
+func pong(n: int) {
+    ping(n)
+}
+ping(1)`)
+	runtimeErr, ok = err.(*interpreter.RuntimeError)
+	if !ok {
+		t.Fatalf("Expected a runtime error, got %v", err)
+	}
+	trace = errors.FormatStack(runtimeErr.Stack, false)
+	expected = fmt.Sprintf("  at pong (Line 5, Column 10)\n  at ping (Line 2, Column 10)\n  ... %d more calls to pong, ping\n  at <main> (Line 7, Column 6)\n", interpreter.MaxCallDepth-2)
+	if trace != expected {
+		t.Errorf("Expected stack trace:\n%s\ngot:\n%s", expected, trace)
+	}
+}
@@ -0,0 +1,113 @@
+package interpreter
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"zen/runtime/async"
+)
+
+func TestTimers(t *testing.T) {
+	clock := async.NewVirtualClock()
+	start := clock.Now()
+	i := InterpretTestFileWithClock(t, "timers.zen", clock)
+	if i == nil {
+		t.Fatal("Failed to interpret test file")
+	}
+
+	AssertValue(t, i, "timeoutLog", "main,0,100,100 again,300,")
+	AssertValue(t, i, "ticks", 3)
+	AssertValue(t, i, "intervalLog", "tick,tick,tick,")
+	AssertValue(t, i, "workerLog", "fast1,slow1,fast2,slow2,")
+	// The program exits once the timeout set last has fired and its callback has completed
+	AssertValue(t, i, "log", "later,")
+
+	// 500ms + 1000ms of sleeping at the top level, 60ms for the workers and 20ms for the last timeout and its callback
+	if elapsed := clock.Now().Sub(start); elapsed != 1580*time.Millisecond {
+		t.Errorf("Expected 1580ms to pass on the virtual clock, got %v", elapsed)
+	}
+}
+
+func TestTimersWallClock(t *testing.T) {
+	// Test sleep waits for real time to pass by default
+	start := time.Now()
+	i, err := InterpretString(`var done = false
+await sleep(20)
+done = true`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	AssertValue(t, i, "done", true)
+	if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+		t.Errorf("Expected sleep to wait at least 20ms, waited %v", elapsed)
+	}
+}
+
+func TestTimersAbort(t *testing.T) {
+	// Test a failing callback stops the event loop, and the program still runs its deferred expressions
+	i, err := InterpretStringWithClock(`var log = ""
+defer { -> log += "exit" }()
+setTimeout({ -> throw new Exception("boom") }, 10)
+setTimeout({ -> log += "never," }, 20)
+await sleep(30)
+log += "never,"`, async.NewVirtualClock())
+	if err == nil || !strings.Contains(err.Error(), "boom") {
+		t.Fatalf("Expected the error of the callback, got %v", err)
+	}
+	AssertValue(t, i, "log", "exit")
+}
+
+func TestTimerErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		expected string
+	}{
+		{
+			name:     "negative delay",
+			code:     `await sleep(-1)`,
+			expected: "Invalid delay -1, expected a non-negative number of milliseconds",
+		},
+		{
+			name:     "interval without time passing",
+			code:     `setInterval({ -> 1 }, 0)`,
+			expected: "Invalid interval 0, expected a positive number of milliseconds",
+		},
+		{
+			name:     "callback that isn't a function",
+			code:     `setTimeout("soon", 10)`,
+			expected: "Cannot schedule value of type string, expected a function",
+		},
+		{
+			name:     "clearing something that isn't a timer",
+			code:     `clearTimer(5)`,
+			expected: "Invalid argument 'timer' in call to 'clearTimer'",
+		},
+		{
+			name: "error in a callback",
+			code: `setTimeout({ -> missing }, 10)
+await sleep(100)`,
+			expected: "Undefined variable 'missing'",
+		},
+		{
+			name: "error in an interval stops the event loop",
+			code: `var count = 0
+setInterval({ ->
+    count += 1
+    if count == 2 {
+        throw new Exception("interval failed")
+    }
+}, 10)`,
+			expected: "interval failed",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := InterpretStringWithClock(test.code, async.NewVirtualClock())
+			if err == nil || !strings.Contains(err.Error(), test.expected) {
+				t.Errorf("Expected error containing %q, got %v", test.expected, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package interpreter
+
+import (
+	"testing"
+)
+
+func TestTraits(t *testing.T) {
+	i := InterpretTestFile(t, "traits.zen")
+	if i == nil {
+		t.Fatal("Failed to interpret test file")
+	}
+
+	AssertValue(t, i, "serialized", "name;id;age;")
+	AssertValue(t, i, "serializable", "name")
+	AssertValue(t, i, "greeting", "hello john")
+	AssertValue(t, i, "salutation", "hello")
+	AssertValue(t, i, "changed", "hi john")
+
+	AssertValue(t, i, "pFieldCount", 3)
+	AssertValue(t, i, "qFieldCount", 4)
+
+	AssertValue(t, i, "robotGreeting", "hello someone")
+	AssertValue(t, i, "employeeSerialized", "name;id;age;")
+	AssertValue(t, i, "employeeGreeting", "hello joe")
+	AssertValue(t, i, "docSerialized", "title;")
+}
+
+func TestTraitErrors(t *testing.T) {
+	// Test two traits providing the same member
+	AssertInterpretError(t, `
+trait A { func name(): string { return "a" } }
+trait B { func name(): string { return "b" } }
+class C {
+    use A
+    use B
+}`)
+	AssertInterpretError(t, `
+trait A { x: int = 0 }
+trait B { x: int = 1 }
+class C {
+    use A
+    use B
+}`)
+
+	// Test that renaming resolves the conflict
+	i, err := InterpretString(`
+trait A { func name(): string { return "a" } }
+trait B { func name(): string { return "b" } }
+class C {
+    use A
+    use B { name as otherName }
+}
+var names = C().name() + C().otherName()`)
+	if err != nil {
+		t.Fatalf("Failed to interpret code: %v", err)
+	}
+	AssertValue(t, i, "names", "ab")
+
+	// Test a trait field clashing with a field of the class
+	AssertInterpretError(t, `
+trait A { x: int = 0 }
+class C {
+    x: int = 1
+    use A
+}`)
+
+	// Test renaming a member the trait doesn't have
+	AssertInterpretError(t, `
+trait A {}
+class C {
+    use A { x as y }
+}`)
+
+	// Test using something that isn't a trait
+	AssertInterpretError(t, `
+class A {}
+class C { use A }`)
+
+	// Test arguments for a trait without init
+	AssertInterpretError(t, `
+trait A {}
+class C { use A(1) }`)
+
+	// Test a trait with more than one init
+	AssertInterpretError(t, `
+trait A {
+    init() {}
+    init(x: int) {}
+}`)
+}
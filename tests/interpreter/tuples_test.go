@@ -0,0 +1,78 @@
+package interpreter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTuples(t *testing.T) {
+	i := InterpretTestFile(t, "tuples.zen")
+	if i == nil {
+		t.Fatal("Failed to interpret test file")
+	}
+
+	AssertValue(t, i, "resultStatus", 404)
+	AssertValue(t, i, "resultBody", "not found")
+	AssertValue(t, i, "resultLength", 2)
+	AssertValue(t, i, "names", "statusCode,body,")
+
+	AssertValue(t, i, "statusCode", 200)
+	AssertValue(t, i, "body", "not found")
+	AssertValue(t, i, "message", "not found")
+	AssertUndefined(t, i, "_")
+
+	AssertValue(t, i, "found", false)
+	AssertValue(t, i, "missing", true)
+	AssertValue(t, i, "value", "set later")
+	AssertValue(t, i, "firstValue", "first")
+	AssertValue(t, i, "firstFound", true)
+}
+
+func TestTupleErrors(t *testing.T) {
+	const httpRequest = `func httpRequest() : statusCode:int, body:string { return 404, "not found" }
+`
+
+	// Test tuples are immutable
+	_, err := InterpretString(httpRequest + `var result = httpRequest()
+result.statusCode = 200`)
+	if err == nil || !strings.Contains(err.Error(), "tuples are immutable") {
+		t.Errorf("Expected immutable tuple error, got %v", err)
+	}
+
+	// Test destructured variables keep the type of their element
+	_, err = InterpretString(httpRequest + `var statusCode, body <= httpRequest()
+statusCode = "ok"`)
+	if err == nil || !strings.Contains(err.Error(), "expected int, got string") {
+		t.Errorf("Expected type error, got %v", err)
+	}
+
+	// Test destructured constants
+	AssertInterpretError(t, httpRequest+`const statusCode, body <= httpRequest()
+statusCode = 200`)
+
+	// Test the number of variables must match the number of values
+	_, err = InterpretString(httpRequest + `var a, b, c <= httpRequest()`)
+	if err == nil || !strings.Contains(err.Error(), "Cannot destructure 2 values into 3 variables") {
+		t.Errorf("Expected count mismatch error, got %v", err)
+	}
+
+	// Test only tuples can be destructured
+	_, err = InterpretString(`var a, b <= 5`)
+	if err == nil || !strings.Contains(err.Error(), "expected a tuple") {
+		t.Errorf("Expected destructuring error, got %v", err)
+	}
+
+	// Test returned values are checked against the return types
+	_, err = InterpretString(`func f() : a:int, b:string { return 1, 2 }
+f()`)
+	if err == nil || !strings.Contains(err.Error(), "b: expected string") {
+		t.Errorf("Expected return type error, got %v", err)
+	}
+	AssertInterpretError(t, `func f() : a:int, b:string { return 1 }
+f()`)
+	_, err = InterpretString(`func f() : int { return 1, 2 }
+f()`)
+	if err == nil || !strings.Contains(err.Error(), "returns a single value of type int, got 2 values") {
+		t.Errorf("Expected single return value error, got %v", err)
+	}
+}
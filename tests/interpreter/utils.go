@@ -6,17 +6,26 @@ import (
 	"runtime"
 	"testing"
 	"zen/interpreter"
+	"zen/runtime/async"
 	"zen/tests/parsing"
 )
 
 // InterpretString parses and interprets source code string
 func InterpretString(source string) (*interpreter.Interpreter, error) {
+	return InterpretStringWithClock(source, nil)
+}
+
+// InterpretStringWithClock parses and interprets source code string, scheduling timers with the given clock, or the wall clock if nil
+func InterpretStringWithClock(source string, clock async.Clock) (*interpreter.Interpreter, error) {
 	program, errors := parsing.ParseString(source)
 	if len(errors) > 0 {
 		return nil, errors[0]
 	}
 
 	i := interpreter.NewInterpreter()
+	if clock != nil {
+		i.SetClock(clock)
+	}
 	err := i.Execute(program)
 	return i, err
 }
@@ -30,6 +39,12 @@ func getTestDataPath(filename string) string {
 
 // InterpretTestFile loads and interprets a test file
 func InterpretTestFile(t *testing.T, filename string) *interpreter.Interpreter {
+	t.Helper()
+	return InterpretTestFileWithClock(t, filename, nil)
+}
+
+// InterpretTestFileWithClock loads and interprets a test file, scheduling timers with the given clock, or the wall clock if nil
+func InterpretTestFileWithClock(t *testing.T, filename string, clock async.Clock) *interpreter.Interpreter {
 	t.Helper()
 	// Load the test file
 	path := getTestDataPath(filename)
@@ -54,6 +69,9 @@ func InterpretTestFile(t *testing.T, filename string) *interpreter.Interpreter {
 	}
 
 	i := interpreter.NewInterpreter()
+	if clock != nil {
+		i.SetClock(clock)
+	}
 	if err := i.Execute(program); err != nil {
 		t.Errorf("Interpreter error: %v", err)
 		return nil
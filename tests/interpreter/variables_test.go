@@ -86,6 +86,15 @@ func TestVariableErrors(t *testing.T) {
 		var y = 42
 		var z = x + y
 	`)
+
+	// Test declared types are enforced on initialization and assignment
+	AssertInterpretError(t, `
+		var x : int = "string"
+	`)
+	AssertInterpretError(t, `
+		var x : int = 1
+		x = "string"
+	`)
 }
 
 func TestVariableScoping(t *testing.T) {
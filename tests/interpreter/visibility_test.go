@@ -0,0 +1,137 @@
+package interpreter
+
+import (
+	"testing"
+	"zen/interpreter"
+)
+
+func TestVisibility(t *testing.T) {
+	i := InterpretTestFile(t, "visibility.zen")
+	if i == nil {
+		t.Fatal("Failed to interpret test file")
+	}
+
+	AssertValue(t, i, "balance", 10)
+	AssertValue(t, i, "id", 1)
+	AssertValue(t, i, "doubled", 20)
+	AssertValue(t, i, "ownerName", "john")
+	AssertValue(t, i, "summary", "savings account of john")
+	AssertValue(t, i, "visitCount", 2)
+	AssertValue(t, i, "revealed", 42)
+}
+
+func TestVisibilityErrors(t *testing.T) {
+	// Test reading a private field outside the class, reported at the access
+	_, err := InterpretString(`class A { private x: int = 1 }
+var a = A()
+var x = a.x`)
+	runtimeErr, ok := err.(*interpreter.RuntimeError)
+	if !ok {
+		t.Fatalf("Expected RuntimeError, got %v", err)
+	}
+	if runtimeErr.Location == nil || runtimeErr.Location.Line != 3 {
+		t.Errorf("Expected error at line 3, got %v", runtimeErr.Location)
+	}
+
+	// Test assigning a private field outside the class
+	AssertInterpretError(t, `
+class A { private x: int = 1 }
+A().x = 2`)
+
+	// Test calling private and protected methods outside the class
+	AssertInterpretError(t, `
+class A { private func f() {} }
+A().f()`)
+	AssertInterpretError(t, `
+class A { protected func f() {} }
+A().f()`)
+
+	// Test accessing a private field of the superclass
+	AssertInterpretError(t, `
+class A { private x: int = 1 }
+class B extends A {
+    func get(): int { return this.x }
+}
+B().get()`)
+
+	// Test calling a private constructor outside the class
+	AssertInterpretError(t, `
+class A { private init() {} }
+A()`)
+
+	// Test overriding a private method
+	AssertInterpretError(t, `
+class A { private func f() {} }
+class B extends A { func f() {} }`)
+
+	// Test narrowing the visibility of an overridden method
+	AssertInterpretError(t, `
+class A { func f() {} }
+class B extends A { protected func f() {} }`)
+
+	// Test implementing an interface method with a less visible method
+	AssertInterpretError(t, `
+interface I { func f() }
+class A implements I { private func f() {} }`)
+
+	// Test a protected interface method implemented by a public method
+	_, err = InterpretString(`
+interface I { protected func f() }
+class A implements I { func f() {} }`)
+	if err != nil {
+		t.Errorf("Expected a public method to implement a protected method: %v", err)
+	}
+}
+
+func TestVisibilityAnalysis(t *testing.T) {
+	// Test access to a private field is reported before the program runs, even if it's never executed
+	i, err := InterpretString(`var ran = true
+class A { private x: int = 1 }
+func read(): int { return A().x }`)
+	runtimeErr, ok := err.(*interpreter.RuntimeError)
+	if !ok {
+		t.Fatalf("Expected RuntimeError, got %v", err)
+	}
+	if runtimeErr.Location == nil || runtimeErr.Location.Line != 3 {
+		t.Errorf("Expected error at line 3, got %v", runtimeErr.Location)
+	}
+	if _, err := i.GetValue("ran"); err == nil {
+		t.Error("Expected the program not to run")
+	}
+
+	// Test private members of parameters declared with a class type, and of constants holding a new object
+	AssertInterpretError(t, `
+class A { private x: int = 1 }
+func read(a: A): int { return a.x }`)
+	AssertInterpretError(t, `
+class A { protected func f() {} }
+const a = new A()
+if false { a.f() }`)
+
+	// Test private members of the superclass in methods of a subclass
+	AssertInterpretError(t, `
+class A { private x: int = 1 }
+class B extends A {
+    func get(): int { return this.x }
+}`)
+
+	// Test members accessible from where they are accessed, or of objects of unknown class, are left to run time
+	_, err = InterpretString(`
+class A {
+    private x: int = 1
+    protected func f(): int { return this.x }
+    func same(other: A): int { return other.x }
+}
+class B extends A {
+    func g(): int { return this.f() }
+}
+class C { pub x: int = 2 }
+const a = A()
+func read(a: C): int { return a.x }
+func protectedOf(a: A) { a.f() }
+var readC = read(C())
+var b = B().g()`)
+	if err != nil {
+		t.Errorf("Expected accessible members to pass the check: %v", err)
+	}
+}
@@ -0,0 +1,93 @@
+package interpreter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWhen(t *testing.T) {
+	i := InterpretTestFile(t, "when.zen")
+	if i == nil {
+		t.Fatal("Failed to interpret test file")
+	}
+
+	AssertValue(t, i, "greeting", "hi john")
+	AssertValue(t, i, "friend", true)
+
+	AssertValue(t, i, "twelve", "child")
+	AssertValue(t, i, "thirteen", "teenager")
+	AssertValue(t, i, "nineteen", "teenager")
+	AssertValue(t, i, "sixtyFive", "senior")
+	AssertValue(t, i, "stepMatched", true)
+	AssertValue(t, i, "stepSkipped", false)
+
+	AssertValue(t, i, "dataType", "int")
+	AssertValue(t, i, "decimalType", "float64")
+
+	AssertValue(t, i, "description", "long")
+	AssertValue(t, i, "untouched", true)
+	AssertValue(t, i, "matches", 1)
+
+	AssertValue(t, i, "idKind", "text")
+
+	// Every member of the union is matched, so there is nothing to warn about
+	if len(i.Warnings()) != 0 {
+		t.Errorf("Expected no warnings, got %v", i.Warnings())
+	}
+}
+
+func TestWhenNonExhaustiveWarning(t *testing.T) {
+	i, err := InterpretString(`
+var id: int | string | bool = "y"
+when id {
+    is int { }
+    "x" { }
+}`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	warnings := i.Warnings()
+	if len(warnings) != 1 {
+		t.Fatalf("Expected 1 warning, got %d", len(warnings))
+	}
+	if !strings.Contains(warnings[0].Message, `no arm matches "y"`) {
+		t.Errorf("Expected warning to name the unmatched value, got %q", warnings[0].Message)
+	}
+
+	// A value matched by a predicate arm is matched, even if no type pattern covers its type
+	i, err = InterpretString(`
+var id: int | string = "abcd"
+when id {
+    is int { }
+    .length > 3 { }
+}`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(i.Warnings()) != 0 {
+		t.Errorf("Expected no warnings, got %v", i.Warnings())
+	}
+
+	// An else arm makes the statement exhaustive
+	i, err = InterpretString(`
+var id: int | string = 1
+when id {
+    is int { }
+    else { }
+}`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(i.Warnings()) != 0 {
+		t.Errorf("Expected no warnings, got %v", i.Warnings())
+	}
+}
+
+func TestWhenErrors(t *testing.T) {
+	// Test predicates that aren't booleans
+	AssertInterpretError(t, `when "abc" { .length { } }`)
+
+	// Test unknown types
+	AssertInterpretError(t, `when 1 { is Nothing { } }`)
+}
@@ -0,0 +1,99 @@
+package interpreter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWhere(t *testing.T) {
+	i := InterpretTestFile(t, "where.zen")
+	if i == nil {
+		t.Fatal("Failed to interpret test file")
+	}
+
+	AssertValue(t, i, "over20Count", 2)
+	AssertValue(t, i, "firstOver20", "jane")
+	AssertValue(t, i, "namedOver20Count", 2)
+	AssertValue(t, i, "notFirstName", "jane")
+
+	AssertValue(t, i, "highScoreCount", 2)
+	AssertValue(t, i, "hasA", false)
+	AssertValue(t, i, "bScore", 8)
+
+	AssertValue(t, i, "johnAge", 21)
+
+	AssertValue(t, i, "names", "janebob")
+	AssertValue(t, i, "matchIndex", 2)
+	AssertValue(t, i, "lastUnknown", true)
+	AssertValue(t, i, "printed", "a")
+	AssertValue(t, i, "checked", 1)
+
+	AssertValue(t, i, "lastTwoSum", 70)
+	AssertValue(t, i, "hasCake", true)
+
+	AssertValue(t, i, "evaluatedBeforeUse", 0)
+	AssertValue(t, i, "largeCount", 3)
+	AssertValue(t, i, "firstLarge", 5)
+	AssertValue(t, i, "veryLargeCount", 2)
+}
+
+func TestWhereErrors(t *testing.T) {
+	// Test filtering values that aren't collections
+	AssertInterpretError(t, `var r = 5 where value > 2`)
+
+	// Test conditions that aren't booleans
+	AssertInterpretError(t, `var r = [1, 2] where value + 1
+var n = r.length`)
+	AssertInterpretError(t, `for n in [1, 2] where n { }`)
+
+	// Test undefined identifiers in the condition are errors once the view is used, rather than naming the element
+	AssertInterpretError(t, `var threshold = 1
+var r = [1, 2] where value > treshold
+var n = r.length`)
+	AssertInterpretError(t, `var r = [1, 2] where a > b`)
+
+	// Test errors evaluating the condition of a printed view are reported
+	_, err := InterpretString(`var people = [{"age": 30}]
+print(people where value{"age"} > limit)`)
+	if err == nil || !strings.Contains(err.Error(), "Undefined variable 'limit'") {
+		t.Errorf("Expected undefined variable error, got %v", err)
+	}
+
+	// Test views can't be modified, as they only read the collection they filter
+	_, err = InterpretString(`var numbers = [1, 5, 10]
+var large = numbers where value > 4
+large.append(20)`)
+	if err == nil || !strings.Contains(err.Error(), "cannot call 'append' on a view") {
+		t.Errorf("Expected error appending to a view, got %v", err)
+	}
+	AssertInterpretError(t, `var numbers = [1, 5, 10]
+var large = numbers where value > 4
+large[0] = 3`)
+	AssertInterpretError(t, `var scores = {"a": 3, "b": 8}
+var high = scores where value > 4
+high{"b"} = 1`)
+
+	// Test lambdas take the element, or its index or key and the element
+	AssertInterpretError(t, `var r = [1, 2] where { -> true }`)
+	AssertInterpretError(t, `var r = [1, 2] where { a, b, c -> true }`)
+}
+
+func TestWhereOuterVariable(t *testing.T) {
+	// Test a condition naming the element after a variable that is defined, so it doesn't refer to the element, is an error
+	_, err := InterpretString(`var person = {"age": 99}
+var people = [{"age": 10}, {"age": 30}]
+var adults = people where person{"age"} > 20`)
+	if err == nil || !strings.Contains(err.Error(), "doesn't refer to the element") {
+		t.Errorf("Expected error for a condition not referring to the element, got %v", err)
+	}
+
+	// Test a variable named like the element doesn't take over a lambda filter
+	i, err := InterpretString(`var person = {"age": 99}
+var people = [{"age": 10}, {"age": 30}]
+var adults = people where { person -> person{"age"} > 20 }
+var adultCount = adults.length`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	AssertValue(t, i, "adultCount", 1)
+}
@@ -0,0 +1,40 @@
+package lexing
+
+import (
+	"testing"
+	"zen/lang/lexing"
+)
+
+func TestLambdas(t *testing.T) {
+	expected := []TokenAssert{
+		// var double = { x -> x * 2 }
+		{Type: lexing.KEYWORD, Literal: "var"},
+		{Type: lexing.IDENTIFIER, Literal: "double"},
+		{Type: lexing.ASSIGN, Literal: "="},
+		{Type: lexing.LEFT_BRACE, Literal: "{"},
+		{Type: lexing.IDENTIFIER, Literal: "x"},
+		{Type: lexing.ARROW, Literal: "->"},
+		{Type: lexing.IDENTIFIER, Literal: "x"},
+		{Type: lexing.MULTIPLY, Literal: "*"},
+		{Type: lexing.INT, Literal: "2"},
+		{Type: lexing.RIGHT_BRACE, Literal: "}"},
+
+		// var op: func(int): int = { -> 1 }
+		{Type: lexing.KEYWORD, Literal: "var"},
+		{Type: lexing.IDENTIFIER, Literal: "op"},
+		{Type: lexing.COLON, Literal: ":"},
+		{Type: lexing.KEYWORD, Literal: "func"},
+		{Type: lexing.LEFT_PAREN, Literal: "("},
+		{Type: lexing.KEYWORD, Literal: "int"},
+		{Type: lexing.RIGHT_PAREN, Literal: ")"},
+		{Type: lexing.COLON, Literal: ":"},
+		{Type: lexing.KEYWORD, Literal: "int"},
+		{Type: lexing.ASSIGN, Literal: "="},
+		{Type: lexing.LEFT_BRACE, Literal: "{"},
+		{Type: lexing.ARROW, Literal: "->"},
+		{Type: lexing.INT, Literal: "1"},
+		{Type: lexing.RIGHT_BRACE, Literal: "}"},
+	}
+
+	LoadAndAssertTokens(t, "lambdas.zen", expected)
+}
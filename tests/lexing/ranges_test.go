@@ -0,0 +1,36 @@
+package lexing
+
+import (
+	"testing"
+	"zen/lang/lexing"
+)
+
+func TestRanges(t *testing.T) {
+	expected := []TokenAssert{
+		// for i in 3..5 {}
+		{Type: lexing.KEYWORD, Literal: "for"},
+		{Type: lexing.IDENTIFIER, Literal: "i"},
+		{Type: lexing.KEYWORD, Literal: "in"},
+		{Type: lexing.INT, Literal: "3"},
+		{Type: lexing.DOT_DOT, Literal: ".."},
+		{Type: lexing.INT, Literal: "5"},
+		{Type: lexing.LEFT_BRACE, Literal: "{"},
+		{Type: lexing.RIGHT_BRACE, Literal: "}"},
+
+		// var r = start..end
+		{Type: lexing.KEYWORD, Literal: "var"},
+		{Type: lexing.IDENTIFIER, Literal: "r"},
+		{Type: lexing.ASSIGN, Literal: "="},
+		{Type: lexing.IDENTIFIER, Literal: "start"},
+		{Type: lexing.DOT_DOT, Literal: ".."},
+		{Type: lexing.IDENTIFIER, Literal: "end"},
+
+		// var f = 1.5
+		{Type: lexing.KEYWORD, Literal: "var"},
+		{Type: lexing.IDENTIFIER, Literal: "f"},
+		{Type: lexing.ASSIGN, Literal: "="},
+		{Type: lexing.FLOAT, Literal: "1.5"},
+	}
+
+	LoadAndAssertTokens(t, "ranges.zen", expected)
+}
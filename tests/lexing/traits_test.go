@@ -0,0 +1,35 @@
+package lexing
+
+import (
+	"testing"
+	"zen/lang/lexing"
+)
+
+func TestTraits(t *testing.T) {
+	expected := []TokenAssert{
+		// trait Tagged {}
+		{Type: lexing.KEYWORD, Literal: "trait"},
+		{Type: lexing.IDENTIFIER, Literal: "Tagged"},
+		{Type: lexing.LEFT_BRACE, Literal: "{"},
+		{Type: lexing.RIGHT_BRACE, Literal: "}"},
+
+		// class Post {
+		{Type: lexing.KEYWORD, Literal: "class"},
+		{Type: lexing.IDENTIFIER, Literal: "Post"},
+		{Type: lexing.LEFT_BRACE, Literal: "{"},
+
+		// use Tagged { tags as _tags }
+		{Type: lexing.KEYWORD, Literal: "use"},
+		{Type: lexing.IDENTIFIER, Literal: "Tagged"},
+		{Type: lexing.LEFT_BRACE, Literal: "{"},
+		{Type: lexing.IDENTIFIER, Literal: "tags"},
+		{Type: lexing.KEYWORD, Literal: "as"},
+		{Type: lexing.IDENTIFIER, Literal: "_tags"},
+		{Type: lexing.RIGHT_BRACE, Literal: "}"},
+
+		// }
+		{Type: lexing.RIGHT_BRACE, Literal: "}"},
+	}
+
+	LoadAndAssertTokens(t, "traits.zen", expected)
+}
@@ -0,0 +1,36 @@
+package lexing
+
+import (
+	"testing"
+	"zen/lang/lexing"
+)
+
+func TestVisibility(t *testing.T) {
+	expected := []TokenAssert{
+		// pub class Account {
+		{Type: lexing.KEYWORD, Literal: "pub"},
+		{Type: lexing.KEYWORD, Literal: "class"},
+		{Type: lexing.IDENTIFIER, Literal: "Account"},
+		{Type: lexing.LEFT_BRACE, Literal: "{"},
+
+		// private balance: int
+		{Type: lexing.KEYWORD, Literal: "private"},
+		{Type: lexing.IDENTIFIER, Literal: "balance"},
+		{Type: lexing.COLON, Literal: ":"},
+		{Type: lexing.KEYWORD, Literal: "int"},
+
+		// protected func audit() {}
+		{Type: lexing.KEYWORD, Literal: "protected"},
+		{Type: lexing.KEYWORD, Literal: "func"},
+		{Type: lexing.IDENTIFIER, Literal: "audit"},
+		{Type: lexing.LEFT_PAREN, Literal: "("},
+		{Type: lexing.RIGHT_PAREN, Literal: ")"},
+		{Type: lexing.LEFT_BRACE, Literal: "{"},
+		{Type: lexing.RIGHT_BRACE, Literal: "}"},
+
+		// }
+		{Type: lexing.RIGHT_BRACE, Literal: "}"},
+	}
+
+	LoadAndAssertTokens(t, "visibility.zen", expected)
+}
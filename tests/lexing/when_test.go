@@ -0,0 +1,42 @@
+package lexing
+
+import (
+	"testing"
+	"zen/lang/lexing"
+)
+
+func TestWhen(t *testing.T) {
+	expected := []TokenAssert{
+		// var v: int | string
+		{Type: lexing.KEYWORD, Literal: "var"},
+		{Type: lexing.IDENTIFIER, Literal: "v"},
+		{Type: lexing.COLON, Literal: ":"},
+		{Type: lexing.KEYWORD, Literal: "int"},
+		{Type: lexing.PIPE, Literal: "|"},
+		{Type: lexing.KEYWORD, Literal: "string"},
+
+		// when v {
+		{Type: lexing.KEYWORD, Literal: "when"},
+		{Type: lexing.IDENTIFIER, Literal: "v"},
+		{Type: lexing.LEFT_BRACE, Literal: "{"},
+
+		// is int {}
+		{Type: lexing.KEYWORD, Literal: "is"},
+		{Type: lexing.KEYWORD, Literal: "int"},
+		{Type: lexing.LEFT_BRACE, Literal: "{"},
+		{Type: lexing.RIGHT_BRACE, Literal: "}"},
+
+		// .length > 5 {}
+		{Type: lexing.DOT, Literal: "."},
+		{Type: lexing.IDENTIFIER, Literal: "length"},
+		{Type: lexing.GREATER, Literal: ">"},
+		{Type: lexing.INT, Literal: "5"},
+		{Type: lexing.LEFT_BRACE, Literal: "{"},
+		{Type: lexing.RIGHT_BRACE, Literal: "}"},
+
+		// }
+		{Type: lexing.RIGHT_BRACE, Literal: "}"},
+	}
+
+	LoadAndAssertTokens(t, "when.zen", expected)
+}
@@ -0,0 +1,92 @@
+package parsing
+
+import (
+	"testing"
+	"zen/lang/parsing/expression"
+	"zen/lang/parsing/statement"
+)
+
+func TestClasses(t *testing.T) {
+	programNode := ParseTestFile(t, "classes.zen")
+	if programNode == nil {
+		return
+	}
+
+	// class Empty {}
+	empty, ok := programNode.Statements[0].(*statement.ClassDeclaration)
+	if !ok {
+		t.Fatalf("Expected ClassDeclaration, got %T", programNode.Statements[0])
+	}
+	if empty.Name != "Empty" || len(empty.Fields) != 0 || len(empty.Methods) != 0 {
+		t.Errorf("Expected empty class Empty, got %s", empty.String(0))
+	}
+
+	person, ok := programNode.Statements[1].(*statement.ClassDeclaration)
+	if !ok {
+		t.Fatalf("Expected ClassDeclaration, got %T", programNode.Statements[1])
+	}
+	if len(person.Fields) != 4 {
+		t.Fatalf("Expected 4 fields, got %d", len(person.Fields))
+	}
+	AssertVarDeclaration(t, person.Fields[0], "name", false, false)
+	age := AssertVarDeclaration(t, person.Fields[1], "age", false, false)
+	if age != nil {
+		AssertLiteralExpression(t, age.Initializer, int64(0))
+	}
+	AssertVarDeclaration(t, person.Fields[2], "species", true, false)
+	AssertVarDeclaration(t, person.Fields[3], "nickname", false, true)
+
+	// methods with and without 'func'
+	if len(person.Methods) != 2 {
+		t.Fatalf("Expected 2 methods, got %d", len(person.Methods))
+	}
+	if person.Methods[0].Name != "init" || person.Methods[1].Name != "greet" {
+		t.Errorf("Expected methods init and greet, got %s and %s", person.Methods[0].Name, person.Methods[1].Name)
+	}
+
+	// this.name = name
+	assign := AssertBinaryExpression(t, person.Methods[0].Body[0].(*statement.ExpressionStatement).Expression, "=")
+	if assign != nil {
+		member, ok := assign.Left.(*expression.MemberAccessExpression)
+		if !ok {
+			t.Fatalf("Expected MemberAccessExpression, got %T", assign.Left)
+		}
+		AssertIdentifierExpression(t, member.Object, "this")
+	}
+
+	// var a = new Empty
+	varDecl := AssertVarDeclaration(t, programNode.Statements[2], "a", false, false)
+	newExpr, ok := varDecl.Initializer.(*expression.NewExpression)
+	if !ok {
+		t.Fatalf("Expected NewExpression, got %T", varDecl.Initializer)
+	}
+	AssertIdentifierExpression(t, newExpr.Class, "Empty")
+	if len(newExpr.Arguments) != 0 {
+		t.Errorf("Expected no arguments, got %d", len(newExpr.Arguments))
+	}
+
+	// var b = new Person("john").greet()
+	varDecl = AssertVarDeclaration(t, programNode.Statements[3], "b", false, false)
+	call, ok := varDecl.Initializer.(*expression.CallExpression)
+	if !ok {
+		t.Fatalf("Expected CallExpression, got %T", varDecl.Initializer)
+	}
+	member, ok := call.Callee.(*expression.MemberAccessExpression)
+	if !ok {
+		t.Fatalf("Expected MemberAccessExpression, got %T", call.Callee)
+	}
+	newExpr, ok = member.Object.(*expression.NewExpression)
+	if !ok {
+		t.Fatalf("Expected NewExpression, got %T", member.Object)
+	}
+	if len(newExpr.Arguments) != 1 {
+		t.Errorf("Expected 1 argument, got %d", len(newExpr.Arguments))
+	}
+}
+
+func TestClassErrors(t *testing.T) {
+	AssertParseError(t, "class { }")
+	AssertParseError(t, "class Person { name }")
+	AssertParseError(t, "class Person { 5 }")
+	AssertParseError(t, "var p = new 5")
+}
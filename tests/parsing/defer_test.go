@@ -0,0 +1,52 @@
+package parsing
+
+import (
+	"testing"
+	"zen/lang/parsing/statement"
+)
+
+func TestDefer(t *testing.T) {
+	programNode := ParseTestFile(t, "defer.zen")
+	if programNode == nil {
+		return
+	}
+	if len(programNode.Statements) != 2 {
+		t.Fatalf("Expected 2 statements, got %d", len(programNode.Statements))
+	}
+
+	// defer file.close()
+	fn := AssertFuncDeclaration(t, programNode.Statements[0])
+	if len(fn.Body) != 2 {
+		t.Fatalf("Expected 2 statements in function body, got %d", len(fn.Body))
+	}
+	deferStmt, ok := fn.Body[0].(*statement.DeferStatement)
+	if !ok {
+		t.Fatalf("Expected DeferStatement, got %T", fn.Body[0])
+	}
+	call := AssertCallExpression(t, deferStmt.Call, 0)
+	AssertMemberAccess(t, call.Callee, "file", "close")
+
+	// defer { -> print("done") }()
+	deferStmt, ok = fn.Body[1].(*statement.DeferStatement)
+	if !ok {
+		t.Fatalf("Expected DeferStatement, got %T", fn.Body[1])
+	}
+	call = AssertCallExpression(t, deferStmt.Call, 0)
+	AssertLambdaExpression(t, call.Callee)
+
+	// defer print("exit")
+	deferStmt, ok = programNode.Statements[1].(*statement.DeferStatement)
+	if !ok {
+		t.Fatalf("Expected DeferStatement, got %T", programNode.Statements[1])
+	}
+	AssertCallExpression(t, deferStmt.Call, 1)
+}
+
+func TestDeferErrors(t *testing.T) {
+	// Defer needs an expression
+	AssertParseError(t, "func f() { defer }")
+
+	// Defer needs a call, whose callee and arguments are evaluated where it is deferred
+	AssertParseError(t, "func f() { defer x += 1 }")
+	AssertParseError(t, "func f() { defer file.close }")
+}
@@ -0,0 +1,67 @@
+package parsing
+
+import (
+	"testing"
+	"zen/lang/parsing/expression"
+	"zen/lang/parsing/statement"
+)
+
+func TestExceptions(t *testing.T) {
+	programNode := ParseTestFile(t, "exceptions.zen")
+	if programNode == nil {
+		return
+	}
+	if len(programNode.Statements) != 2 {
+		t.Fatalf("Expected 2 statements, got %d", len(programNode.Statements))
+	}
+
+	// try { throw ... } catch e: NotFoundException { ... } catch e { ... } finally { ... }
+	tryStmt, ok := programNode.Statements[0].(*statement.TryStatement)
+	if !ok {
+		t.Fatalf("Expected TryStatement, got %T", programNode.Statements[0])
+	}
+	if len(tryStmt.Body) != 1 {
+		t.Fatalf("Expected 1 statement in try block, got %d", len(tryStmt.Body))
+	}
+	throwStmt, ok := tryStmt.Body[0].(*statement.ThrowStatement)
+	if !ok {
+		t.Fatalf("Expected ThrowStatement, got %T", tryStmt.Body[0])
+	}
+	if _, ok := throwStmt.Expression.(*expression.NewExpression); !ok {
+		t.Errorf("Expected NewExpression, got %T", throwStmt.Expression)
+	}
+
+	if len(tryStmt.Catches) != 2 {
+		t.Fatalf("Expected 2 catch clauses, got %d", len(tryStmt.Catches))
+	}
+	if tryStmt.Catches[0].Name != "e" {
+		t.Errorf("Expected exception name e, got %s", tryStmt.Catches[0].Name)
+	}
+	AssertBasicType(t, tryStmt.Catches[0].Type, "NotFoundException")
+	if tryStmt.Catches[1].Type != nil {
+		t.Errorf("Expected catch clause without a type, got %s", tryStmt.Catches[1].Type.String(0))
+	}
+	if len(tryStmt.Finally) != 1 {
+		t.Errorf("Expected 1 statement in finally block, got %d", len(tryStmt.Finally))
+	}
+
+	// try { ... } finally { ... }
+	tryStmt, ok = programNode.Statements[1].(*statement.TryStatement)
+	if !ok {
+		t.Fatalf("Expected TryStatement, got %T", programNode.Statements[1])
+	}
+	if len(tryStmt.Catches) != 0 || len(tryStmt.Finally) != 1 {
+		t.Errorf("Expected no catch clauses and a finally block")
+	}
+}
+
+func TestExceptionErrors(t *testing.T) {
+	// A try block needs a catch clause or a finally block
+	AssertParseError(t, "try { risky() }")
+	// Throw needs an exception
+	AssertParseError(t, "throw")
+	// Catch needs a name
+	AssertParseError(t, "try { risky() } catch { }")
+	// A catch clause without a type catches everything, so no other clause may follow it
+	AssertParseError(t, "try { risky() } catch e { } catch e: TypeException { }")
+}
@@ -0,0 +1,102 @@
+package parsing
+
+import (
+	"testing"
+	"zen/lang/parsing/expression"
+	"zen/lang/parsing/statement"
+)
+
+func TestGenerics(t *testing.T) {
+	programNode := ParseTestFile(t, "generics.zen")
+	if programNode == nil {
+		return
+	}
+	if len(programNode.Statements) != 6 {
+		t.Fatalf("Expected 6 statements, got %d", len(programNode.Statements))
+	}
+
+	// class Box<T> { ... map<U>(...) }
+	box, ok := programNode.Statements[0].(*statement.ClassDeclaration)
+	if !ok {
+		t.Fatalf("Expected ClassDeclaration, got %T", programNode.Statements[0])
+	}
+	AssertTypeParameters(t, box.TypeParameters, "T")
+	if len(box.Methods) != 1 {
+		t.Fatalf("Expected 1 method, got %d", len(box.Methods))
+	}
+	AssertTypeParameters(t, box.Methods[0].TypeParameters, "U")
+
+	// new Box<U>(...)
+	ret := box.Methods[0].Body[0].(*statement.ReturnStatmenet)
+	newExpr, ok := ret.Expression.(*expression.NewExpression)
+	if !ok {
+		t.Fatalf("Expected NewExpression, got %T", ret.Expression)
+	}
+	if len(newExpr.TypeArguments) != 1 || newExpr.TypeArguments[0].String() != "U" {
+		t.Errorf("Expected type arguments <U>, got <%s>", expression.FormatTypeArguments(newExpr.TypeArguments))
+	}
+
+	// class Grid<T, N: int> extends Array<T, N> {}
+	grid, ok := programNode.Statements[1].(*statement.ClassDeclaration)
+	if !ok {
+		t.Fatalf("Expected ClassDeclaration, got %T", programNode.Statements[1])
+	}
+	AssertTypeParameters(t, grid.TypeParameters, "T", "N: int")
+	if grid.Superclass == nil || grid.Superclass.String(0) != "Array<T, N>" {
+		t.Errorf("Expected Grid to extend Array<T, N>, got %v", grid.Superclass)
+	}
+
+	// func first<T>(xs: Array<T>): T
+	first, ok := programNode.Statements[2].(*statement.FuncDeclaration)
+	if !ok {
+		t.Fatalf("Expected FuncDeclaration, got %T", programNode.Statements[2])
+	}
+	AssertTypeParameters(t, first.TypeParameters, "T")
+
+	// var a = first<int>([1, 2])
+	a := AssertVarDeclaration(t, programNode.Statements[3], "a", false, false)
+	call, ok := a.Initializer.(*expression.CallExpression)
+	if !ok {
+		t.Fatalf("Expected CallExpression, got %T", a.Initializer)
+	}
+	if expression.FormatTypeArguments(call.TypeArguments) != "int" || len(call.Arguments) != 1 {
+		t.Errorf("Expected first<int> with 1 argument, got <%s> with %d", expression.FormatTypeArguments(call.TypeArguments), len(call.Arguments))
+	}
+
+	// var b = a < 3 is still a comparison
+	b := AssertVarDeclaration(t, programNode.Statements[4], "b", false, false)
+	if binary, ok := b.Initializer.(*expression.BinaryExpression); !ok || binary.Operator != "<" {
+		t.Errorf("Expected comparison, got %s", b.Initializer.String(0))
+	}
+
+	// var c = grids.make<Grid<int, 3>>()
+	c := AssertVarDeclaration(t, programNode.Statements[5], "c", false, false)
+	call, ok = c.Initializer.(*expression.CallExpression)
+	if !ok {
+		t.Fatalf("Expected CallExpression, got %T", c.Initializer)
+	}
+	if expression.FormatTypeArguments(call.TypeArguments) != "Grid<int, 3>" {
+		t.Errorf("Expected type argument Grid<int, 3>, got %s", expression.FormatTypeArguments(call.TypeArguments))
+	}
+}
+
+func TestGenericErrors(t *testing.T) {
+	AssertParseError(t, "class Box<> {}")
+	AssertParseError(t, "class Box<T, T> {}")
+	AssertParseError(t, "class Box<T {}")
+	AssertParseError(t, "func first<>(xs: Array<int>) {}")
+	AssertParseError(t, "var b = new Box<>(1)")
+}
+
+// AssertTypeParameters checks the type parameters of a generic class or function as they are declared, e.g. N: int
+func AssertTypeParameters(t *testing.T, params []*expression.TypeParameter, expected ...string) {
+	t.Helper()
+	if len(params) != len(expected) {
+		t.Fatalf("Expected %d type parameters, got %d", len(expected), len(params))
+	}
+	for idx, param := range params {
+		if param.String() != expected[idx] {
+			t.Errorf("Expected type parameter %s, got %s", expected[idx], param.String())
+		}
+	}
+}
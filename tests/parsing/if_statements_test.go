@@ -148,3 +148,24 @@ func TestIfStatements(t *testing.T) {
 	AssertIdentifierExpression(t, elseIfCond.Left, "name")
 	AssertLiteralExpression(t, elseIfCond.Right, "jane")
 }
+
+func TestIfConditionBeforeBlock(t *testing.T) {
+	// The block's brace must not be mistaken for map access when it follows a nested expression
+	program, errors := ParseString(`
+		if not loop.last {
+			print(", ")
+		}
+		while not done.value {
+			print("waiting")
+		}
+	`)
+	if len(errors) > 0 {
+		t.Fatalf("Unexpected parse errors: %v", errors)
+	}
+
+	ifStmt := AssertIfStatement(t, program.Statements[0])
+	AssertUnaryExpression(t, ifStmt.PrimaryCondition, "not")
+	if len(ifStmt.PrimaryBlock) != 1 {
+		t.Errorf("Expected 1 statement in if body, got %d", len(ifStmt.PrimaryBlock))
+	}
+}
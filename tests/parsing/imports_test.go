@@ -0,0 +1,78 @@
+package parsing
+
+import (
+	"reflect"
+	"testing"
+	"zen/lang/parsing/statement"
+)
+
+func TestImports(t *testing.T) {
+	programNode := ParseTestFile(t, "imports.zen")
+	if programNode == nil {
+		return
+	}
+	if len(programNode.Statements) != 4 {
+		t.Fatalf("Expected 4 statements, got %d", len(programNode.Statements))
+	}
+
+	// package GameEngine
+	pkg, ok := programNode.Statements[0].(*statement.PackageDeclaration)
+	if !ok {
+		t.Fatalf("Expected PackageDeclaration, got %T", programNode.Statements[0])
+	}
+	if pkg.Name != "GameEngine" {
+		t.Errorf("Expected package GameEngine, got %s", pkg.Name)
+	}
+
+	// import GameEngine/Core
+	imp := AssertImportStatement(t, programNode.Statements[1], []string{"GameEngine", "Core"}, "")
+	if imp.Symbols != nil {
+		t.Errorf("Expected no symbols, got %d", len(imp.Symbols))
+	}
+
+	// import GameEngine/Core/Vector2 as CoreVector2
+	AssertImportStatement(t, programNode.Statements[2], []string{"GameEngine", "Core", "Vector2"}, "CoreVector2")
+
+	// from GameEngine/Core/Utils import hello, world as w
+	imp = AssertImportStatement(t, programNode.Statements[3], []string{"GameEngine", "Core", "Utils"}, "")
+	if len(imp.Symbols) != 2 {
+		t.Fatalf("Expected 2 symbols, got %d", len(imp.Symbols))
+	}
+	if imp.Symbols[0].Name != "hello" || imp.Symbols[0].Alias != "" {
+		t.Errorf("Expected symbol hello, got %s as %s", imp.Symbols[0].Name, imp.Symbols[0].Alias)
+	}
+	if imp.Symbols[1].Name != "world" || imp.Symbols[1].Alias != "w" {
+		t.Errorf("Expected symbol world as w, got %s as %s", imp.Symbols[1].Name, imp.Symbols[1].Alias)
+	}
+}
+
+func TestImportErrors(t *testing.T) {
+	// Imports are only allowed at the top level
+	AssertParseError(t, "func f() { import GameEngine/Core }")
+	AssertParseError(t, "if true { from GameEngine/Core import hello }")
+	AssertParseError(t, "func f() { package GameEngine }")
+
+	// Paths are names separated by slashes
+	AssertParseError(t, "import GameEngine/")
+	AssertParseError(t, "import GameEngine/Core as")
+
+	// A from import needs the symbols to import
+	AssertParseError(t, "from GameEngine/Core hello")
+	AssertParseError(t, "from GameEngine/Core import")
+}
+
+// AssertImportStatement checks that a statement is an import of the given path and alias
+func AssertImportStatement(t *testing.T, stmt interface{}, path []string, alias string) *statement.ImportStatement {
+	t.Helper()
+	imp, ok := stmt.(*statement.ImportStatement)
+	if !ok {
+		t.Fatalf("Expected ImportStatement, got %T", stmt)
+	}
+	if !reflect.DeepEqual(imp.Path, path) {
+		t.Errorf("Expected path %v, got %v", path, imp.Path)
+	}
+	if imp.Alias != alias {
+		t.Errorf("Expected alias %q, got %q", alias, imp.Alias)
+	}
+	return imp
+}
@@ -0,0 +1,42 @@
+package parsing
+
+import (
+	"testing"
+	"zen/lang/parsing/statement"
+)
+
+func TestInclude(t *testing.T) {
+	programNode := ParseTestFile(t, "include.zen")
+	if programNode == nil {
+		return
+	}
+	if len(programNode.Statements) != 2 {
+		t.Fatalf("Expected 2 statements, got %d", len(programNode.Statements))
+	}
+
+	// const config = include("config.zen")
+	decl := AssertVarDeclaration(t, programNode.Statements[0], "config", true, false)
+	call := AssertCallExpression(t, decl.Initializer, 1)
+	AssertIdentifierExpression(t, call.Callee, "include")
+
+	// include("_MyClass_methods.zen") in a class body
+	class, ok := programNode.Statements[1].(*statement.ClassDeclaration)
+	if !ok {
+		t.Fatalf("Expected ClassDeclaration, got %T", programNode.Statements[1])
+	}
+	if len(class.Fields) != 1 || len(class.Methods) != 0 {
+		t.Errorf("Expected 1 field and no methods, got %d fields and %d methods", len(class.Fields), len(class.Methods))
+	}
+	if len(class.Includes) != 1 {
+		t.Fatalf("Expected 1 include, got %d", len(class.Includes))
+	}
+	if class.Includes[0].Path != "_MyClass_methods.zen" {
+		t.Errorf("Expected include of _MyClass_methods.zen, got %s", class.Includes[0].Path)
+	}
+}
+
+func TestIncludeErrors(t *testing.T) {
+	// Includes in a class body take the path as a string
+	AssertParseError(t, "class A { include(path) }")
+	AssertParseError(t, `class A { include("a.zen" }`)
+}
@@ -0,0 +1,54 @@
+package parsing
+
+import (
+	"testing"
+	"zen/lang/parsing/statement"
+)
+
+func TestInheritance(t *testing.T) {
+	programNode := ParseTestFile(t, "inheritance.zen")
+	if programNode == nil {
+		return
+	}
+
+	// class Dog extends Animal
+	dog, ok := programNode.Statements[0].(*statement.ClassDeclaration)
+	if !ok {
+		t.Fatalf("Expected ClassDeclaration, got %T", programNode.Statements[0])
+	}
+	AssertBasicType(t, dog.Superclass, "Animal")
+	if len(dog.Methods) != 2 {
+		t.Fatalf("Expected 2 methods, got %d", len(dog.Methods))
+	}
+
+	// super.init(name)
+	call := AssertCallExpression(t, dog.Methods[0].Body[0].(*statement.ExpressionStatement).Expression, 1)
+	if call != nil {
+		AssertMemberAccess(t, call.Callee, "super", "init")
+	}
+
+	// return super.speak() + "!"
+	ret, ok := dog.Methods[1].Body[0].(*statement.ReturnStatmenet)
+	if !ok {
+		t.Fatalf("Expected ReturnStatement, got %T", dog.Methods[1].Body[0])
+	}
+	concat := AssertBinaryExpression(t, ret.Expression, "+")
+	if concat != nil {
+		speak := AssertCallExpression(t, concat.Left, 0)
+		if speak != nil {
+			AssertMemberAccess(t, speak.Callee, "super", "speak")
+		}
+	}
+
+	// class Stack extends Array<int> {}
+	stack, ok := programNode.Statements[1].(*statement.ClassDeclaration)
+	if !ok {
+		t.Fatalf("Expected ClassDeclaration, got %T", programNode.Statements[1])
+	}
+	AssertParametricType(t, stack.Superclass, "Array", 1)
+}
+
+func TestInheritanceErrors(t *testing.T) {
+	// Test a missing superclass
+	AssertParseError(t, `class Dog extends {}`)
+}
@@ -0,0 +1,142 @@
+package parsing
+
+import (
+	"testing"
+	"zen/lang/parsing/expression"
+	"zen/lang/parsing/statement"
+)
+
+func TestInterfaces(t *testing.T) {
+	programNode := ParseTestFile(t, "interfaces.zen")
+	if programNode == nil {
+		return
+	}
+
+	// interface Drawable { ... }
+	drawable, ok := programNode.Statements[0].(*statement.InterfaceDeclaration)
+	if !ok {
+		t.Fatalf("Expected InterfaceDeclaration, got %T", programNode.Statements[0])
+	}
+	if drawable.Name != "Drawable" {
+		t.Errorf("Expected interface Drawable, got %s", drawable.Name)
+	}
+	if len(drawable.Methods) != 2 {
+		t.Fatalf("Expected 2 methods, got %d", len(drawable.Methods))
+	}
+	if drawable.Methods[0].Name != "draw" || drawable.Methods[1].Name != "resize" {
+		t.Errorf("Expected methods draw and resize, got %s and %s", drawable.Methods[0].Name, drawable.Methods[1].Name)
+	}
+	if drawable.Methods[0].Body != nil {
+		t.Errorf("Expected interface method without a body")
+	}
+	AssertBasicType(t, drawable.Methods[1].ReturnType, "bool")
+	if len(drawable.Properties) != 2 {
+		t.Fatalf("Expected 2 properties, got %d", len(drawable.Properties))
+	}
+	AssertVarDeclaration(t, drawable.Properties[0], "position", false, false)
+	AssertVarDeclaration(t, drawable.Properties[1], "name", true, true)
+
+	// class Circle extends Shape implements Drawable, Named {}
+	circle, ok := programNode.Statements[1].(*statement.ClassDeclaration)
+	if !ok {
+		t.Fatalf("Expected ClassDeclaration, got %T", programNode.Statements[1])
+	}
+	AssertBasicType(t, circle.Superclass, "Shape")
+	if len(circle.Interfaces) != 2 {
+		t.Fatalf("Expected 2 interfaces, got %d", len(circle.Interfaces))
+	}
+	AssertBasicType(t, circle.Interfaces[0], "Drawable")
+	AssertBasicType(t, circle.Interfaces[1], "Named")
+
+	// if shape is Drawable and shape has Drawable
+	ifStmt := AssertIfStatement(t, programNode.Statements[2])
+	and := AssertBinaryExpression(t, ifStmt.PrimaryCondition, "and")
+	if and != nil {
+		is, ok := and.Left.(*expression.IsTypeExpression)
+		if !ok {
+			t.Fatalf("Expected IsTypeExpression, got %T", and.Left)
+		}
+		AssertIdentifierExpression(t, is.Value, "shape")
+		AssertBasicType(t, is.Type, "Drawable")
+
+		has, ok := and.Right.(*expression.HasExpression)
+		if !ok {
+			t.Fatalf("Expected HasExpression, got %T", and.Right)
+		}
+		AssertIdentifierExpression(t, has.Value, "shape")
+		AssertIdentifierExpression(t, has.Interface, "Drawable")
+		if has.Member != "" {
+			t.Errorf("Expected no member, got %s", has.Member)
+		}
+	}
+
+	// var partial = shape has Drawable.draw
+	partial := AssertVarDeclaration(t, programNode.Statements[3], "partial", false, false)
+	if partial != nil {
+		has, ok := partial.Initializer.(*expression.HasExpression)
+		if !ok {
+			t.Fatalf("Expected HasExpression, got %T", partial.Initializer)
+		}
+		AssertIdentifierExpression(t, has.Interface, "Drawable")
+		if has.Member != "draw" {
+			t.Errorf("Expected member draw, got %s", has.Member)
+		}
+	}
+
+	// 'has' is still a valid method name
+	method := AssertVarDeclaration(t, programNode.Statements[4], "stillMethod", false, false)
+	if method != nil {
+		call := AssertCallExpression(t, method.Initializer, 1)
+		if call != nil {
+			AssertMemberAccess(t, call.Callee, "names", "has")
+		}
+	}
+
+	// when shape { is Circle {} has Drawable {} }
+	when, ok := programNode.Statements[5].(*statement.WhenStatement)
+	if !ok {
+		t.Fatalf("Expected WhenStatement, got %T", programNode.Statements[5])
+	}
+	if len(when.Arms) != 2 {
+		t.Fatalf("Expected 2 arms, got %d", len(when.Arms))
+	}
+	AssertWhenPattern(t, when.Arms[0].Patterns[0], statement.WhenType)
+	AssertWhenPattern(t, when.Arms[1].Patterns[0], statement.WhenPredicate)
+	if _, ok := when.Arms[1].Patterns[0].Value.(*expression.HasExpression); !ok {
+		t.Errorf("Expected HasExpression, got %T", when.Arms[1].Patterns[0].Value)
+	}
+
+	// interface Lookup<K, V> { func get(key: K): V }
+	lookup, ok := programNode.Statements[6].(*statement.InterfaceDeclaration)
+	if !ok {
+		t.Fatalf("Expected InterfaceDeclaration, got %T", programNode.Statements[6])
+	}
+	if len(lookup.TypeParameters) != 2 || lookup.TypeParameters[0].Name != "K" || lookup.TypeParameters[1].Name != "V" {
+		t.Errorf("Expected type parameters K, V, got %s", expression.FormatTypeParameters(lookup.TypeParameters))
+	}
+	AssertBasicType(t, lookup.Methods[0].ReturnType, "V")
+
+	// class Table implements Lookup<string, int> {}
+	table, ok := programNode.Statements[7].(*statement.ClassDeclaration)
+	if !ok {
+		t.Fatalf("Expected ClassDeclaration, got %T", programNode.Statements[7])
+	}
+	iface := AssertParametricType(t, table.Interfaces[0], "Lookup", 2)
+	if iface != nil {
+		AssertTypeParameter(t, iface.Parameters[0], "string")
+		AssertTypeParameter(t, iface.Parameters[1], "int")
+	}
+}
+
+func TestInterfaceErrors(t *testing.T) {
+	// Test interface members with a body or default
+	AssertParseError(t, `interface A { draw() {} }`)
+	AssertParseError(t, `interface A { var x: int = 1 }`)
+
+	// Test a missing interface name after 'has' and 'implements'
+	AssertParseError(t, `var b = shape has`)
+	AssertParseError(t, `class A implements {}`)
+
+	// Test a generic interface without type parameters between the brackets
+	AssertParseError(t, `interface A<> { get(): int }`)
+}
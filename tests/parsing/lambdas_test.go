@@ -0,0 +1,71 @@
+package parsing
+
+import (
+	"testing"
+	"zen/lang/parsing/expression"
+	"zen/lang/parsing/statement"
+)
+
+func TestLambdas(t *testing.T) {
+	programNode := ParseTestFile(t, "lambdas.zen")
+	if programNode == nil {
+		return
+	}
+
+	// var double = { x -> x * 2 }
+	varDecl := AssertVarDeclaration(t, programNode.Statements[0], "double", false, false)
+	lambda := AssertLambdaExpression(t, varDecl.Initializer, "x")
+	if len(lambda.Body) != 1 {
+		t.Fatalf("Expected 1 statement in lambda body, got %d", len(lambda.Body))
+	}
+	exprStmt, ok := lambda.Body[0].(*statement.ExpressionStatement)
+	if !ok {
+		t.Fatalf("Expected ExpressionStatement, got %T", lambda.Body[0])
+	}
+	AssertBinaryExpression(t, exprStmt.Expression, "*")
+
+	// var next = { -> count += 1 }
+	varDecl = AssertVarDeclaration(t, programNode.Statements[1], "next", false, false)
+	AssertLambdaExpression(t, varDecl.Initializer)
+
+	// multi-line lambda
+	varDecl = AssertVarDeclaration(t, programNode.Statements[2], "complex_op", false, false)
+	lambda = AssertLambdaExpression(t, varDecl.Initializer, "x", "y")
+	if len(lambda.Body) != 3 {
+		t.Errorf("Expected 3 statements in lambda body, got %d", len(lambda.Body))
+	}
+
+	// var op: func(int, int): int = { a:int, b:int -> a + b }
+	varDecl = AssertVarDeclaration(t, programNode.Statements[3], "op", false, false)
+	funcType, ok := varDecl.Type.(*expression.FunctionType)
+	if !ok {
+		t.Fatalf("Expected FunctionType, got %T", varDecl.Type)
+	}
+	if len(funcType.ParameterTypes) != 2 {
+		t.Errorf("Expected 2 parameter types, got %d", len(funcType.ParameterTypes))
+	}
+	AssertBasicType(t, funcType.ReturnType, "int")
+	lambda = AssertLambdaExpression(t, varDecl.Initializer, "a", "b")
+	AssertBasicType(t, lambda.Parameters[0].Type, "int")
+
+	// map literals
+	varDecl = AssertVarDeclaration(t, programNode.Statements[4], "map", false, false)
+	if _, ok := varDecl.Initializer.(*expression.MapLiteralExpression); !ok {
+		t.Errorf("Expected MapLiteralExpression, got %T", varDecl.Initializer)
+	}
+	varDecl = AssertVarDeclaration(t, programNode.Statements[5], "identMap", false, false)
+	if _, ok := varDecl.Initializer.(*expression.MapLiteralExpression); !ok {
+		t.Errorf("Expected MapLiteralExpression, got %T", varDecl.Initializer)
+	}
+
+	// func make_counter(start: int): func(): int
+	funcDecl := AssertFuncDeclaration(t, programNode.Statements[6])
+	if _, ok := funcDecl.ReturnType.(*expression.FunctionType); !ok {
+		t.Errorf("Expected FunctionType return type, got %T", funcDecl.ReturnType)
+	}
+}
+
+func TestLambdaErrors(t *testing.T) {
+	AssertParseError(t, "var f = { x -> x * 2")
+	AssertParseError(t, "var f: func(int = { x -> x }")
+}
@@ -164,6 +164,60 @@ func TestParametricTypes(t *testing.T) {
 			AssertValueParameter(t, paramType.Parameters[2], int64(2))
 			AssertValueParameter(t, paramType.Parameters[3], int64(2))
 		})
+
+	// Function, nullable and union type arguments
+	AssertVarDeclarationWithType(t, program.Statements[15], "callbacks", false, false,
+		func(t *testing.T, typ ast.Expression) {
+			paramType := AssertParametricType(t, typ, "Array", 1)
+			if paramType == nil {
+				return
+			}
+			if _, ok := paramType.Parameters[0].Value.(*expression.FunctionType); !ok {
+				t.Errorf("Expected FunctionType, got %T", paramType.Parameters[0].Value)
+			}
+			if paramType.Parameters[0].String() != "func(int): string" {
+				t.Errorf("Expected 'func(int): string', got '%s'", paramType.Parameters[0].String())
+			}
+		})
+
+	AssertVarDeclarationWithType(t, program.Statements[16], "maybe", false, false,
+		func(t *testing.T, typ ast.Expression) {
+			paramType := AssertParametricType(t, typ, "Array", 2)
+			if paramType == nil {
+				return
+			}
+			AssertTypeParameter(t, paramType.Parameters[0], "int")
+			if !paramType.Parameters[0].Nullable {
+				t.Error("Expected nullable type parameter")
+			}
+			AssertValueParameter(t, paramType.Parameters[1], int64(3))
+		})
+
+	AssertVarDeclarationWithType(t, program.Statements[17], "mixed", false, false,
+		func(t *testing.T, typ ast.Expression) {
+			paramType := AssertParametricType(t, typ, "Map", 2)
+			if paramType == nil {
+				return
+			}
+			AssertTypeParameter(t, paramType.Parameters[0], "string")
+			if _, ok := paramType.Parameters[1].Value.(*expression.UnionType); !ok {
+				t.Errorf("Expected UnionType, got %T", paramType.Parameters[1].Value)
+			}
+			if paramType.Parameters[1].String() != "int | string" {
+				t.Errorf("Expected 'int | string', got '%s'", paramType.Parameters[1].String())
+			}
+		})
+
+	AssertVarDeclarationWithType(t, program.Statements[18], "optional", false, false,
+		func(t *testing.T, typ ast.Expression) {
+			paramType := AssertParametricType(t, typ, "Map", 2)
+			if paramType == nil {
+				return
+			}
+			if paramType.Parameters[1].String() != "Array<int>?" {
+				t.Errorf("Expected 'Array<int>?', got '%s'", paramType.Parameters[1].String())
+			}
+		})
 }
 
 func TestParametricTypeErrors(t *testing.T) {
@@ -174,6 +228,7 @@ func TestParametricTypeErrors(t *testing.T) {
 		"var x : Array<int int>", // Missing comma
 		"var x : Grid<int, 2, 3", // Missing closing bracket
 		"var x : Array<int 5>",   // Missing comma between parameters
+		"var x : Array<?>",       // Nullable without a type
 	}
 
 	for _, input := range cases {
@@ -206,6 +206,16 @@ func AssertAwaitExpression(t *testing.T, expr ast.Expression) *expression.AwaitE
 	return await
 }
 
+// AssertSpawnExpression checks if an expression is a spawn expression and returns the call it spawns
+func AssertSpawnExpression(t *testing.T, expr ast.Expression) *expression.CallExpression {
+	spawn, ok := expr.(*expression.SpawnExpression)
+	if !ok {
+		t.Errorf("Expected SpawnExpression, got %T", expr)
+		return nil
+	}
+	return spawn.Call
+}
+
 // AssertIdentifierExpression checks if an expression is an identifier with expected name
 func AssertIdentifierExpression(t *testing.T, expr ast.Expression, expectedName string) *expression.IdentifierExpression {
 	id, ok := expr.(*expression.IdentifierExpression)
@@ -302,6 +312,52 @@ func AssertFuncDeclaration(t *testing.T, stmt ast.Statement) *statement.FuncDecl
 	return funcDecl
 }
 
+// AssertLambdaExpression checks if an expression is a lambda with the expected parameter names
+func AssertLambdaExpression(t *testing.T, expr ast.Expression, expectedParams ...string) *expression.LambdaExpression {
+	lambda, ok := expr.(*expression.LambdaExpression)
+	if !ok {
+		t.Errorf("Expected LambdaExpression, got %T", expr)
+		return nil
+	}
+	if len(lambda.Parameters) != len(expectedParams) {
+		t.Errorf("Expected %d lambda parameters, got %d", len(expectedParams), len(lambda.Parameters))
+		return lambda
+	}
+	for i, name := range expectedParams {
+		if lambda.Parameters[i].Name != name {
+			t.Errorf("Expected lambda parameter %q, got %q", name, lambda.Parameters[i].Name)
+		}
+	}
+	return lambda
+}
+
+// AssertRangeExpression checks if an expression is a range
+func AssertRangeExpression(t *testing.T, expr ast.Expression) *expression.RangeExpression {
+	rangeExpr, ok := expr.(*expression.RangeExpression)
+	if !ok {
+		t.Errorf("Expected RangeExpression, got %T", expr)
+		return nil
+	}
+	return rangeExpr
+}
+
+// AssertWhereExpression checks if an expression is a where expression
+func AssertWhereExpression(t *testing.T, expr ast.Expression) *expression.WhereExpression {
+	whereExpr, ok := expr.(*expression.WhereExpression)
+	if !ok {
+		t.Errorf("Expected WhereExpression, got %T", expr)
+		return nil
+	}
+	return whereExpr
+}
+
+// AssertWhenPattern checks if a when pattern is of the expected kind
+func AssertWhenPattern(t *testing.T, pattern *statement.WhenPattern, kind statement.WhenPatternKind) {
+	if pattern.Kind != kind {
+		t.Errorf("Expected when pattern of kind %d, got %d", kind, pattern.Kind)
+	}
+}
+
 // AssertParseError checks if parsing a string produces an error
 func AssertParseError(t *testing.T, input string) {
 	_, errors := ParseString(input)
@@ -0,0 +1,46 @@
+package parsing
+
+import (
+	"testing"
+	"zen/lang/parsing/statement"
+)
+
+func TestRanges(t *testing.T) {
+	programNode := ParseTestFile(t, "ranges.zen")
+	if programNode == nil {
+		return
+	}
+
+	// var r = 3..5
+	varDecl := AssertVarDeclaration(t, programNode.Statements[0], "r", false, false)
+	rangeExpr := AssertRangeExpression(t, varDecl.Initializer)
+	if rangeExpr != nil {
+		AssertLiteralExpression(t, rangeExpr.Start, int64(3))
+		AssertLiteralExpression(t, rangeExpr.End, int64(5))
+	}
+
+	// var shifted = start + 1..end * 2
+	varDecl = AssertVarDeclaration(t, programNode.Statements[1], "shifted", false, false)
+	rangeExpr = AssertRangeExpression(t, varDecl.Initializer)
+	if rangeExpr != nil {
+		AssertBinaryExpression(t, rangeExpr.Start, "+")
+		AssertBinaryExpression(t, rangeExpr.End, "*")
+	}
+
+	// for i in 0..count { print(i) }
+	forIn, ok := programNode.Statements[2].(*statement.ForInStatement)
+	if !ok {
+		t.Fatalf("Expected ForInStatement, got %T", programNode.Statements[2])
+	}
+	rangeExpr = AssertRangeExpression(t, forIn.Container)
+	if rangeExpr != nil {
+		AssertIdentifierExpression(t, rangeExpr.End, "count")
+	}
+	if len(forIn.Body) != 1 {
+		t.Errorf("Expected 1 statement in for-in body, got %d", len(forIn.Body))
+	}
+}
+
+func TestRangeErrors(t *testing.T) {
+	AssertParseError(t, "var r = 3..")
+}
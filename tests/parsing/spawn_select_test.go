@@ -0,0 +1,76 @@
+package parsing
+
+import (
+	"testing"
+	"zen/lang/parsing/statement"
+)
+
+func TestSpawnSelect(t *testing.T) {
+	print("parsing spawn_select.zen\n")
+	programNode := ParseTestFile(t, "spawn_select.zen")
+
+	if programNode == nil {
+		return
+	}
+
+	// spawn worker(jobs, 5)
+	exprStmt, ok := programNode.Statements[0].(*statement.ExpressionStatement)
+	if !ok {
+		t.Errorf("Expected ExpressionStatement, got %T", programNode.Statements[0])
+		return
+	}
+	call := AssertSpawnExpression(t, exprStmt.Expression)
+	AssertIdentifierExpression(t, call.Callee, "worker")
+	if len(call.Arguments) != 2 {
+		t.Errorf("Expected 2 arguments, got %d", len(call.Arguments))
+	}
+
+	// var total = await spawn sum(1, 100)
+	varDecl := AssertVarDeclaration(t, programNode.Statements[1], "total", false, false)
+	await := AssertAwaitExpression(t, varDecl.Initializer)
+	call = AssertSpawnExpression(t, await.Expression)
+	AssertIdentifierExpression(t, call.Callee, "sum")
+
+	// select { ... }
+	sel, ok := programNode.Statements[2].(*statement.SelectStatement)
+	if !ok {
+		t.Errorf("Expected SelectStatement, got %T", programNode.Statements[2])
+		return
+	}
+	if len(sel.Arms) != 3 {
+		t.Fatalf("Expected 3 arms, got %d", len(sel.Arms))
+	}
+	if !sel.HasElse || len(sel.Else) != 1 {
+		t.Errorf("Expected an else arm with 1 statement")
+	}
+
+	receive := sel.Arms[0]
+	AssertIdentifierExpression(t, receive.Channel, "results")
+	if receive.Send || receive.Alias != "result" || len(receive.Body) != 1 {
+		t.Errorf("Expected a receive named result with 1 statement, got %+v", receive)
+	}
+
+	send := sel.Arms[1]
+	AssertIdentifierExpression(t, send.Channel, "jobs")
+	AssertIdentifierExpression(t, send.Value, "job")
+	if !send.Send || send.Alias != "" {
+		t.Errorf("Expected an unnamed send, got %+v", send)
+	}
+
+	if sel.Arms[2].Send || sel.Arms[2].Alias != "" {
+		t.Errorf("Expected an unnamed receive, got %+v", sel.Arms[2])
+	}
+}
+
+func TestSpawnSelectErrors(t *testing.T) {
+	AssertParseError(t, "spawn worker")
+	AssertParseError(t, "spawn 5")
+	AssertParseError(t, "select")
+	AssertParseError(t, "select { }")
+	AssertParseError(t, "select { else { } ch.receive() { } }")
+	AssertParseError(t, "select { print(1) { } }")
+	AssertParseError(t, "select { ch.send() { } }")
+	AssertParseError(t, "select { ch.send(1, 2) { } }")
+	AssertParseError(t, "select { ch.receive(1) { } }")
+	AssertParseError(t, "select { ch.send(1) as x { } }")
+}
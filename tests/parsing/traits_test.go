@@ -0,0 +1,73 @@
+package parsing
+
+import (
+	"testing"
+	"zen/lang/parsing/statement"
+)
+
+func TestTraits(t *testing.T) {
+	programNode := ParseTestFile(t, "traits.zen")
+	if programNode == nil {
+		return
+	}
+
+	// trait Serialization { ... }
+	trait, ok := programNode.Statements[0].(*statement.TraitDeclaration)
+	if !ok {
+		t.Fatalf("Expected TraitDeclaration, got %T", programNode.Statements[0])
+	}
+	if trait.Name != "Serialization" {
+		t.Errorf("Expected trait Serialization, got %s", trait.Name)
+	}
+	if len(trait.Fields) != 1 {
+		t.Fatalf("Expected 1 field, got %d", len(trait.Fields))
+	}
+	AssertVarDeclaration(t, trait.Fields[0], "serializableFields", false, false)
+	if len(trait.Methods) != 2 {
+		t.Fatalf("Expected 2 methods, got %d", len(trait.Methods))
+	}
+	if trait.Methods[0].Name != "init" || trait.Methods[1].Name != "serialize" {
+		t.Errorf("Expected methods init and serialize, got %s and %s", trait.Methods[0].Name, trait.Methods[1].Name)
+	}
+
+	person, ok := programNode.Statements[1].(*statement.ClassDeclaration)
+	if !ok {
+		t.Fatalf("Expected ClassDeclaration, got %T", programNode.Statements[1])
+	}
+	if len(person.Fields) != 1 {
+		t.Errorf("Expected 1 field, got %d", len(person.Fields))
+	}
+	if len(person.Uses) != 2 {
+		t.Fatalf("Expected 2 trait uses, got %d", len(person.Uses))
+	}
+
+	// use Serialization(["name"]) { serializableFields as _serializable; serialize as toText }
+	use := person.Uses[0]
+	AssertIdentifierExpression(t, use.Trait, "Serialization")
+	if len(use.Arguments) != 1 {
+		t.Errorf("Expected 1 argument, got %d", len(use.Arguments))
+	}
+	if len(use.Aliases) != 2 {
+		t.Fatalf("Expected 2 aliases, got %d", len(use.Aliases))
+	}
+	if use.Aliases[0].Member != "serializableFields" || use.Aliases[0].Alias != "_serializable" {
+		t.Errorf("Expected serializableFields as _serializable, got %s as %s", use.Aliases[0].Member, use.Aliases[0].Alias)
+	}
+	if use.Aliases[1].Member != "serialize" || use.Aliases[1].Alias != "toText" {
+		t.Errorf("Expected serialize as toText, got %s as %s", use.Aliases[1].Member, use.Aliases[1].Alias)
+	}
+
+	// use Tagged
+	AssertIdentifierExpression(t, person.Uses[1].Trait, "Tagged")
+	if len(person.Uses[1].Arguments) != 0 || len(person.Uses[1].Aliases) != 0 {
+		t.Errorf("Expected no arguments or aliases for Tagged")
+	}
+}
+
+func TestTraitErrors(t *testing.T) {
+	// Test an alias without 'as'
+	AssertParseError(t, `class A { use T { x y } }`)
+
+	// Test a missing trait name
+	AssertParseError(t, `class A { use }`)
+}
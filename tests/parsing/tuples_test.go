@@ -0,0 +1,86 @@
+package parsing
+
+import (
+	"testing"
+	"zen/lang/parsing/ast"
+	"zen/lang/parsing/expression"
+	"zen/lang/parsing/statement"
+)
+
+func TestTuples(t *testing.T) {
+	programNode := ParseTestFile(t, "tuples.zen")
+	if programNode == nil {
+		return
+	}
+	if len(programNode.Statements) != 3 {
+		t.Fatalf("Expected 3 statements, got %d", len(programNode.Statements))
+	}
+
+	// func httpRequest() : statusCode:int, body:string?
+	fn := AssertFuncDeclaration(t, programNode.Statements[0])
+	tupleType, ok := fn.ReturnType.(*expression.TupleType)
+	if !ok {
+		t.Fatalf("Expected TupleType, got %T", fn.ReturnType)
+	}
+	if len(tupleType.Elements) != 2 {
+		t.Fatalf("Expected 2 return values, got %d", len(tupleType.Elements))
+	}
+	if tupleType.Elements[0].Name != "statusCode" || tupleType.Elements[0].IsNullable {
+		t.Errorf("Expected statusCode: int, got %s", tupleType.String(0))
+	}
+	AssertBasicType(t, tupleType.Elements[0].Type, "int")
+	if tupleType.Elements[1].Name != "body" || !tupleType.Elements[1].IsNullable {
+		t.Errorf("Expected body: string?, got %s", tupleType.String(0))
+	}
+
+	// return 404, "not found"
+	ret := fn.Body[0].(*statement.ReturnStatmenet)
+	tuple, ok := ret.Expression.(*expression.TupleExpression)
+	if !ok {
+		t.Fatalf("Expected TupleExpression, got %T", ret.Expression)
+	}
+	if len(tuple.Elements) != 2 {
+		t.Fatalf("Expected 2 values, got %d", len(tuple.Elements))
+	}
+	AssertLiteralExpression(t, tuple.Elements[0], int64(404))
+	AssertLiteralExpression(t, tuple.Elements[1], "not found")
+
+	// var statusCode, body <= httpRequest()
+	AssertDestructuringDeclaration(t, programNode.Statements[1], false, "statusCode", "body")
+
+	// const _, message <= httpRequest()
+	AssertDestructuringDeclaration(t, programNode.Statements[2], true, "_", "message")
+}
+
+func TestTupleErrors(t *testing.T) {
+	// Return values must be named
+	AssertParseError(t, "func f() : a:int, string { return 1, \"x\" }")
+	// Return value names must be unique
+	AssertParseError(t, "func f() : a:int, a:string { return 1, \"x\" }")
+	// Destructuring requires <=
+	AssertParseError(t, "var a, b = f()")
+	// Variable names must be unique
+	AssertParseError(t, "var a, a <= f()")
+}
+
+// AssertDestructuringDeclaration checks that a statement declares the given variables from a tuple
+func AssertDestructuringDeclaration(t *testing.T, stmt ast.Statement, isConst bool, names ...string) *statement.DestructuringDeclaration {
+	t.Helper()
+	decl, ok := stmt.(*statement.DestructuringDeclaration)
+	if !ok {
+		t.Fatalf("Expected DestructuringDeclaration, got %T", stmt)
+	}
+	if decl.IsConstant != isConst {
+		t.Errorf("Expected IsConstant to be %v", isConst)
+	}
+	if len(decl.Names) != len(names) {
+		t.Fatalf("Expected names %v, got %v", names, decl.Names)
+	}
+	for idx, name := range names {
+		if decl.Names[idx] != name {
+			t.Errorf("Expected names %v, got %v", names, decl.Names)
+		}
+	}
+	AssertCallExpression(t, decl.Initializer, 0)
+	return decl
+}
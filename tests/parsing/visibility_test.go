@@ -0,0 +1,96 @@
+package parsing
+
+import (
+	"testing"
+	"zen/lang/parsing/ast"
+	"zen/lang/parsing/statement"
+)
+
+func TestVisibility(t *testing.T) {
+	programNode := ParseTestFile(t, "visibility.zen")
+	if programNode == nil {
+		return
+	}
+	if len(programNode.Statements) != 5 {
+		t.Fatalf("Expected 5 statements, got %d", len(programNode.Statements))
+	}
+
+	// pub class Account { ... }
+	class, ok := programNode.Statements[0].(*statement.ClassDeclaration)
+	if !ok {
+		t.Fatalf("Expected ClassDeclaration, got %T", programNode.Statements[0])
+	}
+	if class.Visibility != ast.Public {
+		t.Errorf("Expected pub class, got %s", class.Visibility)
+	}
+	if len(class.Fields) != 3 {
+		t.Fatalf("Expected 3 fields, got %d", len(class.Fields))
+	}
+	expectedFields := []ast.Visibility{ast.Private, ast.Protected, ast.Public}
+	for idx, expected := range expectedFields {
+		if class.Fields[idx].Visibility != expected {
+			t.Errorf("Expected field %s to be %s, got %s", class.Fields[idx].Name, expected, class.Fields[idx].Visibility)
+		}
+	}
+	if len(class.Methods) != 3 {
+		t.Fatalf("Expected 3 methods, got %d", len(class.Methods))
+	}
+	expectedMethods := []ast.Visibility{ast.Public, ast.Private, ast.Protected}
+	for idx, expected := range expectedMethods {
+		if class.Methods[idx].Visibility != expected {
+			t.Errorf("Expected method %s to be %s, got %s", class.Methods[idx].Name, expected, class.Methods[idx].Visibility)
+		}
+	}
+
+	// private const secret = 42
+	secret, ok := programNode.Statements[1].(*statement.VarDeclarationNode)
+	if !ok {
+		t.Fatalf("Expected VarDeclarationNode, got %T", programNode.Statements[1])
+	}
+	AssertVarDeclaration(t, secret, "secret", true, false)
+	if secret.Visibility != ast.Private {
+		t.Errorf("Expected private const, got %s", secret.Visibility)
+	}
+
+	// private func helper() {}
+	helper, ok := programNode.Statements[2].(*statement.FuncDeclaration)
+	if !ok {
+		t.Fatalf("Expected FuncDeclaration, got %T", programNode.Statements[2])
+	}
+	if helper.Visibility != ast.Private {
+		t.Errorf("Expected private func, got %s", helper.Visibility)
+	}
+
+	// interface BracketGet { protected func _bracketGet(key: string): int }
+	iface, ok := programNode.Statements[3].(*statement.InterfaceDeclaration)
+	if !ok {
+		t.Fatalf("Expected InterfaceDeclaration, got %T", programNode.Statements[3])
+	}
+	if len(iface.Methods) != 1 || iface.Methods[0].Visibility != ast.Protected {
+		t.Errorf("Expected protected method _bracketGet")
+	}
+
+	// pub trait Named { private name: string }
+	trait, ok := programNode.Statements[4].(*statement.TraitDeclaration)
+	if !ok {
+		t.Fatalf("Expected TraitDeclaration, got %T", programNode.Statements[4])
+	}
+	if len(trait.Fields) != 1 || trait.Fields[0].Visibility != ast.Private {
+		t.Errorf("Expected private field name")
+	}
+}
+
+func TestVisibilityErrors(t *testing.T) {
+	// Test protected top-level declarations
+	AssertParseError(t, `protected func f() {}`)
+
+	// Test a modifier without a declaration
+	AssertParseError(t, `private print("x")`)
+
+	// Test modifiers inside blocks
+	AssertParseError(t, `func f() { private var x = 1 }`)
+	AssertParseError(t, `if true { pub func g() {} }`)
+
+	// Test private interface members
+	AssertParseError(t, `interface I { private func f() }`)
+}
@@ -0,0 +1,80 @@
+package parsing
+
+import (
+	"testing"
+	"zen/lang/parsing/expression"
+	"zen/lang/parsing/statement"
+)
+
+func TestWhen(t *testing.T) {
+	programNode := ParseTestFile(t, "when.zen")
+	if programNode == nil {
+		return
+	}
+
+	when, ok := programNode.Statements[0].(*statement.WhenStatement)
+	if !ok {
+		t.Fatalf("Expected WhenStatement, got %T", programNode.Statements[0])
+	}
+	AssertIdentifierExpression(t, when.Subject, "name")
+	if len(when.Arms) != 5 {
+		t.Fatalf("Expected 5 arms, got %d", len(when.Arms))
+	}
+
+	// "john" or "jane" { ... }
+	arm := when.Arms[0]
+	if len(arm.Patterns) != 2 {
+		t.Fatalf("Expected 2 patterns, got %d", len(arm.Patterns))
+	}
+	AssertWhenPattern(t, arm.Patterns[0], statement.WhenValue)
+	AssertLiteralExpression(t, arm.Patterns[0].Value, "john")
+	AssertLiteralExpression(t, arm.Patterns[1].Value, "jane")
+
+	// 0..12 { ... }
+	AssertWhenPattern(t, when.Arms[1].Patterns[0], statement.WhenValue)
+	AssertRangeExpression(t, when.Arms[1].Patterns[0].Value)
+
+	// is int | float { ... }
+	pattern := when.Arms[2].Patterns[0]
+	AssertWhenPattern(t, pattern, statement.WhenType)
+	if union, ok := pattern.Type.(*expression.UnionType); !ok || len(union.Types) != 2 {
+		t.Errorf("Expected union of 2 types, got %s", pattern.Type.String(0))
+	}
+
+	// .length > 5 { ... }
+	pattern = when.Arms[3].Patterns[0]
+	AssertWhenPattern(t, pattern, statement.WhenPredicate)
+	binary := AssertBinaryExpression(t, pattern.Value, ">")
+	if binary != nil {
+		member, ok := binary.Left.(*expression.MemberAccessExpression)
+		if !ok {
+			t.Fatalf("Expected MemberAccessExpression, got %T", binary.Left)
+		}
+		if _, ok := member.Object.(*expression.WhenSubjectExpression); !ok {
+			t.Errorf("Expected WhenSubjectExpression, got %T", member.Object)
+		}
+	}
+
+	// other { ... } is an identifier, not map access
+	AssertWhenPattern(t, when.Arms[4].Patterns[0], statement.WhenValue)
+	AssertIdentifierExpression(t, when.Arms[4].Patterns[0].Value, "other")
+	if len(when.Arms[4].Body) != 1 {
+		t.Errorf("Expected 1 statement in arm body, got %d", len(when.Arms[4].Body))
+	}
+
+	if !when.HasElse || len(when.Else) != 1 {
+		t.Errorf("Expected else arm with 1 statement")
+	}
+
+	// var v: int | string? = null
+	varDecl := AssertVarDeclaration(t, programNode.Statements[1], "v", false, true)
+	if union, ok := varDecl.Type.(*expression.UnionType); !ok || len(union.Types) != 2 {
+		t.Errorf("Expected union of 2 types, got %T", varDecl.Type)
+	}
+}
+
+func TestWhenErrors(t *testing.T) {
+	AssertParseError(t, "when x { else { } 1 { } }")
+	AssertParseError(t, "when x { 1 }")
+	AssertParseError(t, "when x { is { } }")
+}
@@ -0,0 +1,60 @@
+package parsing
+
+import (
+	"testing"
+	"zen/lang/parsing/expression"
+	"zen/lang/parsing/statement"
+)
+
+func TestWhere(t *testing.T) {
+	programNode := ParseTestFile(t, "where.zen")
+	if programNode == nil {
+		return
+	}
+
+	// var adults = people where person{"age"} > 20
+	varDecl := AssertVarDeclaration(t, programNode.Statements[0], "adults", false, false)
+	whereExpr := AssertWhereExpression(t, varDecl.Initializer)
+	if whereExpr != nil {
+		AssertIdentifierExpression(t, whereExpr.Collection, "people")
+		AssertBinaryExpression(t, whereExpr.Condition, ">")
+	}
+
+	// var rest = people where index > 0 and value != null
+	varDecl = AssertVarDeclaration(t, programNode.Statements[1], "rest", false, false)
+	whereExpr = AssertWhereExpression(t, varDecl.Initializer)
+	if whereExpr != nil {
+		AssertBinaryExpression(t, whereExpr.Condition, "and")
+	}
+
+	// for article in articles where article.views > 100 { print(article) }
+	forIn, ok := programNode.Statements[2].(*statement.ForInStatement)
+	if !ok {
+		t.Fatalf("Expected ForInStatement, got %T", programNode.Statements[2])
+	}
+	AssertIdentifierExpression(t, forIn.Container, "articles")
+	if forIn.Condition == nil {
+		t.Fatal("Expected for-in statement to have a where condition")
+	}
+	AssertBinaryExpression(t, forIn.Condition, ">")
+	if len(forIn.Body) != 1 {
+		t.Errorf("Expected 1 statement in for-in body, got %d", len(forIn.Body))
+	}
+
+	// var named = people where { person -> person{"age"} > 20 }
+	varDecl = AssertVarDeclaration(t, programNode.Statements[3], "named", false, false)
+	whereExpr = AssertWhereExpression(t, varDecl.Initializer)
+	if whereExpr != nil {
+		lambda, ok := whereExpr.Condition.(*expression.LambdaExpression)
+		if !ok {
+			t.Fatalf("Expected LambdaExpression, got %T", whereExpr.Condition)
+		}
+		if len(lambda.Parameters) != 1 || lambda.Parameters[0].Name != "person" {
+			t.Errorf("Expected the lambda to take person, got %v", lambda.Parameters)
+		}
+	}
+}
+
+func TestWhereErrors(t *testing.T) {
+	AssertParseError(t, "var r = people where")
+}